@@ -84,12 +84,21 @@ func GetBlockByNumber(db common.Database, number uint64) *types.Block {
 
 // WriteHead force writes the current head
 func WriteHead(db common.Database, block *types.Block) error {
+	batch := db.NewBatch()
+	if err := writeHead(batch, block); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// writeHead adds the writes making block the current head to batch
+func writeHead(batch common.Batch, block *types.Block) error {
 	key := append(blockNumPre, block.Number().Bytes()...)
-	err := db.Put(key, block.Hash().Bytes())
+	err := batch.Put(key, block.Hash().Bytes())
 	if err != nil {
 		return err
 	}
-	err = db.Put([]byte("LastBlock"), block.Hash().Bytes())
+	err = batch.Put([]byte("LastBlock"), block.Hash().Bytes())
 	if err != nil {
 		return err
 	}
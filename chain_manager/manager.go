@@ -316,20 +316,24 @@ func (self *ChainManager) ExportN(w io.Writer, first uint64, last uint64) error
 // insert injects a block into the current chain block chain. Note, this function
 // assumes that the `mu` mutex is held!
 func (bc *ChainManager) insert(block *types.Block) {
-	err := WriteHead(bc.blockDb, block)
+	batch := bc.blockDb.NewBatch()
+	err := writeHead(batch, block)
 	if err != nil {
 		klog.Error("db write fail:%v", err)
 	}
 
 	bc.checkpoint++
 	if bc.checkpoint > checkpointLimit {
-		err = bc.blockDb.Put([]byte("checkpoint"), block.Hash().Bytes())
+		err = batch.Put([]byte("checkpoint"), block.Hash().Bytes())
 		if err != nil {
 			klog.Error("db write fail:%v", err)
 		}
 
 		bc.checkpoint = 0
 	}
+	if err := batch.Write(); err != nil {
+		klog.Error("db write fail:%v", err)
+	}
 
 	bc.currentBlock = block
 	bc.lastBlockHash = block.Hash()
@@ -340,7 +344,13 @@ func (bc *ChainManager) write(block *types.Block) {
 
 	enc, _ := rlp.EncodeToBytes((*types.StorageBlock)(block))
 	key := append(blockHashPre, block.Hash().Bytes()...)
-	err := bc.blockDb.Put(key, enc)
+	// Write the block through a batch so that it is on disk before a head
+	// written by insert can point to it.
+	batch := bc.blockDb.NewBatch()
+	err := batch.Put(key, enc)
+	if err == nil {
+		err = batch.Write()
+	}
 	if err != nil {
 		klog.Error("db write fail:%v", err)
 	}
@@ -482,6 +492,9 @@ func (self *ChainManager) WriteBlock(block *types.Block, queued bool) (status wr
 	self.wg.Add(1)
 	defer self.wg.Done()
 
+	// The block has to be stored before it can become the head.
+	self.write(block)
+
 	cblock := self.currentBlock
 	// Compare the TD of the last known block in the canonical chain to make sure it's greater.
 	// At this point it's possible that a different chain (fork) becomes the new canonical chain.
@@ -510,7 +523,6 @@ func (self *ChainManager) WriteBlock(block *types.Block, queued bool) (status wr
 		status = SideStatTy
 	}
 
-	self.write(block)
 	// Delete from future blocks
 	self.futureBlocks.Remove(block.Hash())
 
@@ -9,21 +9,25 @@ import (
 
 var receiptsPre = []byte("receipts-")
 
+// TxExtra is the lookup metadata stored next to every canonical transaction.
+type TxExtra struct {
+	BlockHash  common.Hash
+	BlockIndex uint64
+	Index      uint64
+}
+
 // PutTransactions stores the transactions in the given database
 func PutTransactions(db common.Database, block *types.Block, txs types.Transactions) {
+	batch := db.NewBatch()
 	for i, tx := range block.Transactions() {
 		rlpEnc, err := rlp.EncodeToBytes(tx)
 		if err != nil {
 			klog.Error("Failed encoding tx", err)
 			return
 		}
-		db.Put(tx.Hash().Bytes(), rlpEnc)
+		batch.Put(tx.Hash().Bytes(), rlpEnc)
 
-		var txExtra struct {
-			BlockHash  common.Hash
-			BlockIndex uint64
-			Index      uint64
-		}
+		var txExtra TxExtra
 		txExtra.BlockHash = block.Hash()
 		txExtra.BlockIndex = block.NumberU64()
 		txExtra.Index = uint64(i)
@@ -32,25 +36,54 @@ func PutTransactions(db common.Database, block *types.Block, txs types.Transacti
 			klog.Error("Failed encoding tx meta data", err)
 			return
 		}
-		db.Put(append(tx.Hash().Bytes(), 0x0001), rlpMeta)
+		batch.Put(append(tx.Hash().Bytes(), 0x0001), rlpMeta)
+	}
+	if err := batch.Write(); err != nil {
+		klog.Error("Failed writing txs", err)
+	}
+}
+
+// GetTransaction returns a transaction by hash together with its position in
+// the chain, or nil if the transaction is not known
+func GetTransaction(db common.Database, txHash common.Hash) (*types.Transaction, *TxExtra) {
+	data, _ := db.Get(txHash[:])
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var tx types.Transaction
+	if err := rlp.DecodeBytes(data, &tx); err != nil {
+		klog.Error("GetTransaction err:", err)
+		return nil, nil
+	}
+
+	meta, _ := db.Get(append(txHash.Bytes(), 0x0001))
+	if len(meta) == 0 {
+		return &tx, nil
+	}
+	var txExtra TxExtra
+	if err := rlp.DecodeBytes(meta, &txExtra); err != nil {
+		klog.Error("GetTransaction meta err:", err)
+		return &tx, nil
 	}
+	return &tx, &txExtra
 }
 
 // PutReceipts stores the receipts in the current database
 func PutReceipts(db common.Database, receipts types.Receipts) error {
+	batch := db.NewBatch()
 	for _, receipt := range receipts {
 		storageReceipt := (*types.ReceiptForStorage)(receipt)
 		bytes, err := rlp.EncodeToBytes(storageReceipt)
 		if err != nil {
 			return err
 		}
-		err = db.Put(append(receiptsPre, receipt.TxHash[:]...), bytes)
+		err = batch.Put(append(receiptsPre, receipt.TxHash[:]...), bytes)
 		if err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return batch.Write()
 }
 
 // GetReceipt returns a receipt by hash
@@ -0,0 +1,38 @@
+package chain_manager
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/crypto"
+	"github.com/MonteCarloClub/KBD/model/kdb"
+	"github.com/MonteCarloClub/KBD/types"
+)
+
+func TestPutGetTransaction(t *testing.T) {
+	db, _ := kdb.NewMemDatabase()
+	key, _ := crypto.GenerateKey()
+	var txs types.Transactions
+	for i := uint64(0); i < 3; i++ {
+		tx, _ := types.NewTransaction(i, common.Address{}, big.NewInt(100), big.NewInt(21000), big.NewInt(1), nil).SignECDSA(key)
+		txs = append(txs, tx)
+	}
+	block := types.NewBlock(&types.Header{Number: big.NewInt(7), Difficulty: big.NewInt(1), GasLimit: big.NewInt(1), GasUsed: big.NewInt(0)}, txs, nil, nil)
+	PutTransactions(db, block, block.Transactions())
+
+	tx, extra := GetTransaction(db, txs[2].Hash())
+	if tx == nil || tx.Hash() != txs[2].Hash() {
+		t.Fatal("expected transaction to be found")
+	}
+	if extra == nil {
+		t.Fatal("expected transaction meta data to be found")
+	}
+	if extra.BlockHash != block.Hash() || extra.BlockIndex != 7 || extra.Index != 2 {
+		t.Errorf("unexpected meta data %+v", extra)
+	}
+
+	if tx, _ := GetTransaction(db, common.Hash{1}); tx != nil {
+		t.Error("expected unknown transaction to be nil")
+	}
+}
@@ -0,0 +1,94 @@
+package chain_manager
+
+import (
+	"math/big"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/execution"
+	"github.com/MonteCarloClub/KBD/model/kbpool"
+	"github.com/MonteCarloClub/KBD/model/state"
+	"github.com/MonteCarloClub/KBD/model/vm"
+	"github.com/MonteCarloClub/KBD/types"
+)
+
+// VMEnv is the vm.Environment used to run messages on top of a block of
+// the canonical chain.
+type VMEnv struct {
+	state  *state.StateDB
+	header *types.Header
+	msg    kbpool.Message
+	depth  int
+	chain  *ChainManager
+	typ    vm.Type
+	// structured logging
+	logs []vm.StructLog
+}
+
+func NewEnv(state *state.StateDB, chain *ChainManager, msg kbpool.Message, header *types.Header) *VMEnv {
+	return &VMEnv{
+		chain:  chain,
+		state:  state,
+		header: header,
+		msg:    msg,
+		typ:    vm.StdVmTy,
+	}
+}
+
+func (self *VMEnv) Origin() common.Address   { f, _ := self.msg.From(); return f }
+func (self *VMEnv) BlockNumber() *big.Int    { return self.header.Number }
+func (self *VMEnv) Coinbase() common.Address { return self.header.Coinbase }
+func (self *VMEnv) Time() uint64             { return self.header.Time }
+func (self *VMEnv) Difficulty() *big.Int     { return self.header.Difficulty }
+func (self *VMEnv) GasLimit() *big.Int       { return self.header.GasLimit }
+func (self *VMEnv) Value() *big.Int          { return self.msg.Value() }
+func (self *VMEnv) State() *state.StateDB    { return self.state }
+func (self *VMEnv) Depth() int               { return self.depth }
+func (self *VMEnv) SetDepth(i int)           { self.depth = i }
+func (self *VMEnv) VmType() vm.Type          { return self.typ }
+func (self *VMEnv) SetVmType(t vm.Type)      { self.typ = t }
+func (self *VMEnv) GetHash(n uint64) common.Hash {
+	if self.chain == nil {
+		return common.Hash{}
+	}
+	for block := self.chain.GetBlock(self.header.ParentHash); block != nil; block = self.chain.GetBlock(block.ParentHash()) {
+		if block.NumberU64() == n {
+			return block.Hash()
+		}
+	}
+
+	return common.Hash{}
+}
+
+func (self *VMEnv) AddLog(log *state.Log) {
+	self.state.AddLog(log)
+}
+func (self *VMEnv) Transfer(from, to vm.Account, amount *big.Int) error {
+	return vm.Transfer(from, to, amount)
+}
+
+func (self *VMEnv) vm(addr *common.Address, data []byte, gas, price, value *big.Int) *execution.Execution {
+	return execution.NewExecution(self, addr, data, gas, price, value)
+}
+
+func (self *VMEnv) Call(me vm.ContextRef, addr common.Address, data []byte, gas, price, value *big.Int) ([]byte, error) {
+	exe := self.vm(&addr, data, gas, price, value)
+	return exe.Call(addr, me)
+}
+func (self *VMEnv) CallCode(me vm.ContextRef, addr common.Address, data []byte, gas, price, value *big.Int) ([]byte, error) {
+	maddr := me.Address()
+	exe := self.vm(&maddr, data, gas, price, value)
+	return exe.Call(addr, me)
+}
+
+func (self *VMEnv) Create(me vm.ContextRef, data []byte, gas, price, value *big.Int) ([]byte, error, vm.ContextRef) {
+	exe := self.vm(nil, data, gas, price, value)
+	return exe.Create(me)
+}
+
+func (self *VMEnv) StructLogs() []vm.StructLog {
+	return self.logs
+}
+
+func (self *VMEnv) AddStructLog(log vm.StructLog) {
+	self.logs = append(self.logs, log)
+}
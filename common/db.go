@@ -7,4 +7,24 @@ type Database interface {
 	Delete(key []byte) error
 	Close()
 	Flush() error
+	NewBatch() Batch
+	NewIterator(prefix, start []byte) Iterator
+}
+
+// Batch collects writes which are applied together by Write.
+type Batch interface {
+	Put(key []byte, value []byte) error
+	Delete(key []byte) error
+	Write() error
+	Reset()
+}
+
+// Iterator walks the entries of a database in key order. Key and Value are
+// only valid until the next call to Next.
+type Iterator interface {
+	Next() bool
+	Key() []byte
+	Value() []byte
+	Error() error
+	Release()
 }
@@ -0,0 +1,123 @@
+// Package codec encodes stored values. Every encoded value starts with a
+// one-byte header naming the codec it was encoded with, so values encoded
+// with different codecs can be kept side by side and read back alike.
+package codec
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/MonteCarloClub/KBD/compression/rle"
+	"github.com/golang/snappy"
+)
+
+// Codec is a value encoding, identified by the header byte it writes.
+type Codec interface {
+	ID() byte
+	Name() string
+	Encode(data []byte) []byte
+	Decode(data []byte) ([]byte, error)
+}
+
+const (
+	IDNone   byte = 0
+	IDRLE    byte = 1
+	IDSnappy byte = 2
+)
+
+var (
+	ErrUnknownCodec = errors.New("unknown codec")
+	ErrNoHeader     = errors.New("value has no codec header")
+	ErrCodecExists  = errors.New("codec already registered")
+)
+
+var (
+	mu     sync.RWMutex
+	byID   = make(map[byte]Codec)
+	byName = make(map[string]Codec)
+)
+
+func init() {
+	Register(None{})
+	Register(RLE{})
+	Register(Snappy{})
+}
+
+// Register adds a codec. Its id and name must not be taken.
+func Register(c Codec) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, ok := byID[c.ID()]; ok {
+		return ErrCodecExists
+	}
+	if _, ok := byName[c.Name()]; ok {
+		return ErrCodecExists
+	}
+	byID[c.ID()] = c
+	byName[c.Name()] = c
+	return nil
+}
+
+// Lookup returns the codec with the given id.
+func Lookup(id byte) (Codec, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if c, ok := byID[id]; ok {
+		return c, nil
+	}
+	return nil, ErrUnknownCodec
+}
+
+// ByName returns the codec with the given name.
+func ByName(name string) (Codec, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	if c, ok := byName[name]; ok {
+		return c, nil
+	}
+	return nil, ErrUnknownCodec
+}
+
+// Encode encodes data with c behind its header.
+func Encode(c Codec, data []byte) []byte {
+	return append([]byte{c.ID()}, c.Encode(data)...)
+}
+
+// Decode decodes a value with the codec named in its header.
+func Decode(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, ErrNoHeader
+	}
+	c, err := Lookup(data[0])
+	if err != nil {
+		return nil, err
+	}
+	return c.Decode(data[1:])
+}
+
+// None stores values as they are.
+type None struct{}
+
+func (None) ID() byte                           { return IDNone }
+func (None) Name() string                       { return "none" }
+func (None) Encode(data []byte) []byte          { return data }
+func (None) Decode(data []byte) ([]byte, error) { return append([]byte{}, data...), nil }
+
+// RLE is the run-length encoding of compression/rle.
+type RLE struct{}
+
+func (RLE) ID() byte                           { return IDRLE }
+func (RLE) Name() string                       { return "rle" }
+func (RLE) Encode(data []byte) []byte          { return rle.Compress(data) }
+func (RLE) Decode(data []byte) ([]byte, error) { return rle.Decompress(data) }
+
+// Snappy is snappy block compression.
+type Snappy struct{}
+
+func (Snappy) ID() byte                           { return IDSnappy }
+func (Snappy) Name() string                       { return "snappy" }
+func (Snappy) Encode(data []byte) []byte          { return snappy.Encode(nil, data) }
+func (Snappy) Decode(data []byte) ([]byte, error) { return snappy.Decode(nil, data) }
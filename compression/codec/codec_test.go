@@ -0,0 +1,36 @@
+package codec
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	data := append(bytes.Repeat([]byte{0}, 300), []byte("hello hello hello \xfe")...)
+	for _, name := range []string{"none", "rle", "snappy"} {
+		c, err := ByName(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		enc := Encode(c, data)
+		if enc[0] != c.ID() {
+			t.Errorf("%s: expected header %d, got %d", name, c.ID(), enc[0])
+		}
+		dec, err := Decode(enc)
+		if err != nil || !bytes.Equal(dec, data) {
+			t.Errorf("%s: round trip failed: %v", name, err)
+		}
+	}
+}
+
+func TestUnknown(t *testing.T) {
+	if _, err := Decode([]byte{0xff, 1}); err != ErrUnknownCodec {
+		t.Errorf("expected ErrUnknownCodec, got %v", err)
+	}
+	if _, err := Decode(nil); err != ErrNoHeader {
+		t.Errorf("expected ErrNoHeader, got %v", err)
+	}
+	if err := Register(RLE{}); err != ErrCodecExists {
+		t.Errorf("expected ErrCodecExists, got %v", err)
+	}
+}
@@ -5,7 +5,37 @@ const (
 	BlockDBFile = "BlockDB"
 	ExtraDBFile = "ExtraDB"
 	NodeDBFile  = "NodeDB"
+	KVDBFile    = "KVDB"
+	SinkDBFile  = "SinkDB"
+	BlobDBFile  = "BlobDB"
+	SinkConfig  = "sinks.json"
 	LogFile     = "log.txt"
 )
 
 const DataDir = "/tmp"
+
+// StorageEngineEnv is the environment variable selecting the storage engine
+// of StateDB, BlockDB and ExtraDB, "leveldb" (the default) or "memory".
+const StorageEngineEnv = "KBD_STORAGE_ENGINE"
+
+// The environment variables below tune when the write queue of a leveldb
+// database is flushed, see kdb.FlushConfig. Sizes are in bytes, the age is a
+// duration such as "30s". Unset variables keep the default.
+const (
+	FlushBytesEnv    = "KBD_FLUSH_BYTES"
+	FlushKeysEnv     = "KBD_FLUSH_KEYS"
+	FlushAgeEnv      = "KBD_FLUSH_AGE"
+	MaxQueueBytesEnv = "KBD_MAX_QUEUE_BYTES"
+)
+
+// CacheSizeEnv is the environment variable setting how many values a leveldb
+// database caches for reads, see kdb.DefaultCacheSize.
+const CacheSizeEnv = "KBD_CACHE_SIZE"
+
+// ValueCodecEnv is the environment variable naming the codec values are
+// written with, "rle" (the default), "snappy" or "none".
+const ValueCodecEnv = "KBD_VALUE_CODEC"
+
+// KVRetentionDays is how long past revisions of KV data are kept, zero
+// keeping them forever.
+const KVRetentionDays = 30
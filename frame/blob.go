@@ -0,0 +1,31 @@
+package frame
+
+import (
+	"path"
+	"sync"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+
+	"github.com/MonteCarloClub/KBD/constant"
+	"github.com/MonteCarloClub/KBD/model/blob"
+	"github.com/MonteCarloClub/KBD/model/kdb"
+)
+
+var blobs *blob.Blobs
+var blobsOnce sync.Once
+
+func initBlobs() {
+	file := path.Join("/", constant.DataDir, constant.BlobDBFile)
+	db, err := kdb.NewLDBDatabase(file)
+	if err != nil {
+		klog.Errorf("[initBlobs] open blob db failed %v", err)
+		return
+	}
+	configureLDB(db)
+	blobs = blob.New(db)
+}
+
+func GetBlobs() *blob.Blobs {
+	blobsOnce.Do(initBlobs)
+	return blobs
+}
@@ -0,0 +1,50 @@
+package frame
+
+import (
+	"github.com/cloudwego/kitex/pkg/klog"
+
+	"github.com/MonteCarloClub/KBD/chain_manager"
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/constant"
+	"github.com/MonteCarloClub/KBD/model/pow/ezp"
+)
+
+const genesisNonce = 42
+
+var extraDB common.Database
+var chainManager *chain_manager.ChainManager
+
+func initExtraDB() {
+	extraDB, _ = openDB(constant.ExtraDBFile)
+}
+
+func GetExtraDB() common.Database {
+	if extraDB == nil {
+		initExtraDB()
+	}
+	return extraDB
+}
+
+func GetBlockDB() common.Database {
+	if blockDB == nil {
+		initBlock()
+	}
+	return blockDB
+}
+
+func initChainManager() (err error) {
+	genesis := chain_manager.GenesisBlock(genesisNonce, GetDB())
+	chainManager, err = chain_manager.NewChainManager(genesis, GetBlockDB(), GetDB(), GetExtraDB(), ezp.New(), eventMux)
+	if err != nil {
+		klog.Errorf("[initChainManager] chain manager init failed %v", err)
+	}
+	return err
+}
+
+// GetChainManager returns the chain manager or nil if it could not be set up.
+func GetChainManager() *chain_manager.ChainManager {
+	if chainManager == nil {
+		initChainManager()
+	}
+	return chainManager
+}
@@ -0,0 +1,100 @@
+package frame
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+
+	"github.com/MonteCarloClub/KBD/model/changelog"
+	"github.com/MonteCarloClub/KBD/model/event"
+)
+
+var changeLog *changelog.Log
+var changeLogOnce sync.Once
+
+// initChangeLog opens the change log, kept in KVDB so that KV writes and
+// their changes are written in one batch, and starts posting a ChangeEvent on
+// the event mux for every change appended from now on.
+func initChangeLog() {
+	db := GetKVDB()
+	if db == nil {
+		return
+	}
+	log, err := changelog.New(db)
+	if err != nil {
+		klog.Errorf("[initChangeLog] open change log failed %v", err)
+		return
+	}
+	changeLog = log
+	go postChanges(log, log.Head()+1)
+}
+
+// postChanges posts the changes from seq on as they are appended. It reads
+// them back from the log, so writers never wait for subscribers.
+func postChanges(log *changelog.Log, seq uint64) {
+	for {
+		changes, err := log.Wait(seq, 100, time.Minute)
+		if err != nil {
+			klog.Errorf("[postChanges] read changes from %d failed %v", seq, err)
+			time.Sleep(time.Second)
+			continue
+		}
+		for i := range changes {
+			eventMux.Post(event.ChangeEvent{Change: &changes[i]})
+			seq = changes[i].Seq + 1
+		}
+	}
+}
+
+func GetChangeLog() *changelog.Log {
+	changeLogOnce.Do(initChangeLog)
+	return changeLog
+}
+
+// accountRootKey holds in KVDB the state root after the last account write
+// appended to the change log. It is written in the same batch as the change,
+// which makes that batch the point the write is committed at.
+var accountRootKey = []byte("account-root")
+
+// CommitAccount commits an account write whose trie nodes are on disk: the
+// change is appended to the change log together with the new state root, which
+// is then recorded in BlockDB. If that last step is lost, syncRoot catches up
+// when the state is opened again.
+func CommitAccount(root []byte, change changelog.Change) error {
+	log := GetChangeLog()
+	if log == nil {
+		return PutRoot(root)
+	}
+	p, err := log.Begin([]changelog.Change{change})
+	if err != nil {
+		return err
+	}
+	keys := append(p.Keys, accountRootKey)
+	values := append(p.Values, root)
+	if err := GetKVDB().WriteBatch(keys, values, nil); err != nil {
+		p.Abort()
+		klog.Errorf("[CommitAccount] append change failed %v", err)
+		return err
+	}
+	p.Commit()
+	return PutRoot(root)
+}
+
+// syncRoot brings the state root in BlockDB up to the one committed with the
+// last account change.
+func syncRoot() {
+	db := GetKVDB()
+	if db == nil {
+		return
+	}
+	committed, err := db.Get(accountRootKey)
+	if err != nil || bytes.Equal(committed, GetRoot()) {
+		return
+	}
+	klog.Infof("[syncRoot] state root %x committed with the change log", committed)
+	if err := PutRoot(committed); err != nil {
+		klog.Errorf("[syncRoot] put root failed %v", err)
+	}
+}
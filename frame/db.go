@@ -1,29 +1,99 @@
 package frame
 
 import (
+	"os"
 	"path"
+	"strconv"
+	"time"
 
 	"github.com/cloudwego/kitex/pkg/klog"
 
 	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/compression/codec"
 	"github.com/MonteCarloClub/KBD/constant"
 	"github.com/MonteCarloClub/KBD/model/kdb"
 	"github.com/MonteCarloClub/KBD/model/state"
 )
 
 var runState *state.StateDB
-var stateDB *kdb.LDBDatabase
-var blockDB *kdb.LDBDatabase
+var stateDB common.Database
+var blockDB common.Database
 var root []byte
 
 func Init() {
 	initState()
 	initBlock()
+	initSinks()
+}
+
+// openDB opens one of the chain databases with the configured engine.
+func openDB(name string) (common.Database, error) {
+	engine := kdb.Engine(os.Getenv(constant.StorageEngineEnv))
+	db, err := kdb.NewDatabase(engine, path.Join("/", constant.DataDir, name))
+	if err != nil {
+		klog.Errorf("[openDB] open %s with engine %q failed %v", name, engine, err)
+		return nil, err
+	}
+	if ldb, ok := db.(*kdb.LDBDatabase); ok {
+		configureLDB(ldb)
+	}
+	return db, nil
+}
+
+// configureLDB applies the settings from the environment to a leveldb
+// database.
+func configureLDB(db *kdb.LDBDatabase) {
+	db.SetFlushConfig(kdb.FlushConfig{
+		FlushBytes:    envInt(constant.FlushBytesEnv),
+		FlushKeys:     envInt(constant.FlushKeysEnv),
+		FlushAge:      envDuration(constant.FlushAgeEnv),
+		MaxQueueBytes: envInt(constant.MaxQueueBytesEnv),
+	})
+	if size := envInt(constant.CacheSizeEnv); size > 0 {
+		db.SetCacheSize(size)
+	}
+	if name := os.Getenv(constant.ValueCodecEnv); name != "" {
+		c, err := codec.ByName(name)
+		if err != nil {
+			klog.Errorf("[configureLDB] codec %s = %q failed %v", constant.ValueCodecEnv, name, err)
+		} else {
+			db.SetCodec(c)
+		}
+	}
+}
+
+// envInt returns the integer in the environment variable name, zero if it is
+// unset or not an integer.
+func envInt(name string) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		klog.Errorf("[envInt] %s = %q is not an integer", name, value)
+		return 0
+	}
+	return n
+}
+
+// envDuration returns the duration in the environment variable name, zero if
+// it is unset or not a duration.
+func envDuration(name string) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return 0
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		klog.Errorf("[envDuration] %s = %q is not a duration", name, value)
+		return 0
+	}
+	return d
 }
 
 func initBlock() (err error) {
-	file := path.Join("/", constant.DataDir, constant.BlockDBFile)
-	blockDB, err = kdb.NewLDBDatabase(file)
+	blockDB, err = openDB(constant.BlockDBFile)
 	return err
 }
 
@@ -40,29 +110,28 @@ func GetRoot() []byte {
 	}
 	return res
 }
-func PutRoot(value []byte) {
+
+// PutRoot records value as the current state root and returns once it is on
+// disk.
+func PutRoot(value []byte) error {
 	if blockDB == nil {
-		err := initBlock()
-		if err != nil {
+		if err := initBlock(); err != nil {
 			klog.Error("[PutRoot] root init failed")
-			return
+			return err
 		}
 	}
-	err := blockDB.Put([]byte("root"), value)
-	if err != nil {
+	if err := blockDB.Put([]byte("root"), value); err != nil {
 		klog.Error("[PutRoot] put root failed")
-		return
+		return err
 	}
-	blockDB.Flush()
-	return
+	return blockDB.Flush()
 }
 
 func initStateDB() {
-	file := path.Join("/", constant.DataDir, constant.StateDBFile)
-	stateDB, _ = kdb.NewLDBDatabase(file)
+	stateDB, _ = openDB(constant.StateDBFile)
 }
 
-func GetDB() *kdb.LDBDatabase {
+func GetDB() common.Database {
 	if stateDB == nil {
 		initStateDB()
 	}
@@ -70,6 +139,7 @@ func GetDB() *kdb.LDBDatabase {
 }
 
 func initState() {
+	syncRoot()
 	runState = state.New(common.BytesToHash(GetRoot()), GetDB())
 }
 
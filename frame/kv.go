@@ -0,0 +1,144 @@
+package frame
+
+import (
+	"bytes"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/constant"
+	"github.com/MonteCarloClub/KBD/crypto"
+	"github.com/MonteCarloClub/KBD/model/kdb"
+	"github.com/MonteCarloClub/KBD/model/kvtx"
+)
+
+// kvRootInterval is how often the root of the KV trie is recorded.
+const kvRootInterval = time.Minute
+
+// kvDBDurability is how KV writes are persisted before they are acknowledged.
+var kvDBDurability = kdb.DurabilityWAL
+
+var kvDB *kdb.LDBDatabase
+var txManager *kvtx.Manager
+
+// RPC handlers ask for these concurrently, each is opened once.
+var kvDBOnce, txManagerOnce sync.Once
+
+// initKVDB opens the KV data apart from StateDB, so that client keys can
+// never overwrite trie nodes or code.
+func initKVDB() {
+	file := path.Join("/", constant.DataDir, constant.KVDBFile)
+	db, err := kdb.NewLDBDatabase(file)
+	if err != nil {
+		klog.Errorf("[initKVDB] open kv db failed %v", err)
+		return
+	}
+	configureLDB(db)
+	if err := db.SetDurability(kvDBDurability); err != nil {
+		klog.Errorf("[initKVDB] set durability failed %v", err)
+	}
+	kvDB = db
+}
+
+func GetKVDB() *kdb.LDBDatabase {
+	kvDBOnce.Do(initKVDB)
+	return kvDB
+}
+
+func initTxManager() {
+	if GetKVDB() == nil {
+		return
+	}
+	m := kvtx.NewManager(GetKVDB())
+	m.SetRetention(constant.KVRetentionDays * 24 * time.Hour)
+	if log := GetChangeLog(); log != nil {
+		m.SetChangeLog(log)
+	}
+	if err := m.EnableTrie(GetKVDB(), kvRootInterval); err != nil {
+		klog.Errorf("[initTxManager] kv trie init failed %v", err)
+	}
+	if err := migrateStateKV(m); err != nil {
+		klog.Errorf("[initTxManager] migrate kv data from state db failed %v", err)
+	}
+	txManager = m
+}
+
+// GetTxManager returns the manager all KV writes go through.
+func GetTxManager() *kvtx.Manager {
+	txManagerOnce.Do(initTxManager)
+	return txManager
+}
+
+// migratedKey marks in KVDB that the KV data kept in StateDB before KVDB
+// existed has been copied over.
+var migratedKey = []byte("migrated-statedb")
+
+// stateKeyPrefixes prefix the keys in StateDB which are not KV data.
+var stateKeyPrefixes = [][]byte{[]byte("secure-key-")}
+
+// isStateKey reports whether a StateDB entry belongs to the state rather
+// than to the KV data. Trie nodes and code are stored under their hash.
+func isStateKey(key, value []byte) bool {
+	if bytes.Equal(crypto.Sha3(value), key) {
+		return true
+	}
+	for _, prefix := range stateKeyPrefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// migrateStateKV copies the KV data written to StateDB by earlier versions
+// into the default namespace, once. The keys are imported, so the copies
+// don't show up in the history or the change log. The StateDB entries are
+// left in place.
+func migrateStateKV(m *kvtx.Manager) error {
+	if _, err := GetKVDB().Get(migratedKey); err == nil {
+		return nil
+	}
+	state := GetDB()
+	if state == nil {
+		return nil
+	}
+
+	var keys, values [][]byte
+	n := 0
+	write := func() error {
+		if len(keys) == 0 {
+			return nil
+		}
+		if err := m.Import(keys, values); err != nil {
+			return err
+		}
+		n += len(keys)
+		keys, values = nil, nil
+		return nil
+	}
+	it := state.NewIterator(nil, nil)
+	defer it.Release()
+	for it.Next() {
+		if isStateKey(it.Key(), it.Value()) {
+			continue
+		}
+		keys = append(keys, kvtx.DataKey(kvtx.DefaultNamespace, common.CopyBytes(it.Key())))
+		values = append(values, it.Value())
+		if len(keys) == 1000 {
+			if err := write(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	if err := write(); err != nil {
+		return err
+	}
+	klog.Infof("[migrateStateKV] copied %d keys from state db", n)
+	return GetKVDB().Put(migratedKey, []byte{1})
+}
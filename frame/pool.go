@@ -0,0 +1,27 @@
+package frame
+
+import (
+	"math/big"
+
+	"github.com/MonteCarloClub/KBD/model/event"
+	"github.com/MonteCarloClub/KBD/model/kbpool"
+	"github.com/MonteCarloClub/KBD/params"
+)
+
+var eventMux = new(event.TypeMux)
+var txPool *kbpool.TxPool
+
+func GetEventMux() *event.TypeMux {
+	return eventMux
+}
+
+func initTxPool() {
+	txPool = kbpool.NewTxPool(eventMux, GetState, func() *big.Int { return params.GenesisGasLimit })
+}
+
+func GetTxPool() *kbpool.TxPool {
+	if txPool == nil {
+		initTxPool()
+	}
+	return txPool
+}
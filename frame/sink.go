@@ -0,0 +1,132 @@
+package frame
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+
+	"github.com/MonteCarloClub/KBD/chain_manager"
+	"github.com/MonteCarloClub/KBD/constant"
+	"github.com/MonteCarloClub/KBD/model"
+	"github.com/MonteCarloClub/KBD/model/event"
+	"github.com/MonteCarloClub/KBD/model/kdb"
+	"github.com/MonteCarloClub/KBD/model/sink"
+)
+
+// sinkEvents maps the event names sinks can select to the event posted on the
+// mux they are taken from. Logs are read from the receipts of the blocks of
+// ChainEvents.
+var sinkEvents = map[string]interface{}{
+	"ChainEvent":      chain_manager.ChainEvent{},
+	"ChainSideEvent":  chain_manager.ChainSideEvent{},
+	"ChainSplitEvent": chain_manager.ChainSplitEvent{},
+	"ChainHeadEvent":  chain_manager.ChainHeadEvent{},
+	"TxPreEvent":      event.TxPreEvent{},
+	"ChangeEvent":     event.ChangeEvent{},
+	"Logs":            chain_manager.ChainEvent{},
+}
+
+var sinks []*sink.Dispatcher
+
+// initSinks starts the sinks configured in constant.SinkConfig, a JSON list
+// of sink.Config, if there is one.
+func initSinks() {
+	data, err := ioutil.ReadFile(path.Join("/", constant.DataDir, constant.SinkConfig))
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		klog.Errorf("[initSinks] read config failed %v", err)
+		return
+	}
+	var configs []sink.Config
+	if err := json.Unmarshal(data, &configs); err != nil {
+		klog.Errorf("[initSinks] parse config failed %v", err)
+		return
+	}
+
+	spool, err := kdb.NewLDBDatabase(path.Join("/", constant.DataDir, constant.SinkDBFile))
+	if err != nil {
+		klog.Errorf("[initSinks] open spool failed %v", err)
+		return
+	}
+	configureLDB(spool)
+	if err := spool.SetDurability(kdb.DurabilityWAL); err != nil {
+		klog.Errorf("[initSinks] set durability failed %v", err)
+	}
+	for _, config := range configs {
+		if err := startSink(config, spool); err != nil {
+			klog.Errorf("[initSinks] start sink %s failed %v", config.Name, err)
+		}
+	}
+}
+
+func startSink(config sink.Config, spool sink.Spool) error {
+	wanted := make(map[string]bool)
+	var types []interface{}
+	seen := make(map[interface{}]bool)
+	for _, name := range config.Events {
+		ev, ok := sinkEvents[name]
+		if !ok {
+			klog.Warnf("[startSink] %s: unknown event %s", config.Name, name)
+			continue
+		}
+		wanted[name] = true
+		if !seen[ev] {
+			seen[ev] = true
+			types = append(types, ev)
+		}
+	}
+	if len(types) == 0 {
+		return nil
+	}
+
+	s, err := config.NewSink()
+	if err != nil {
+		return err
+	}
+	d, err := sink.NewDispatcher(config.Name, s, spool, eventMux, types, func(ev interface{}) []sink.Record {
+		return sinkRecords(ev, wanted)
+	}, config.BatchSize)
+	if err != nil {
+		s.Close()
+		return err
+	}
+	sinks = append(sinks, d)
+	return nil
+}
+
+// sinkRecords encodes an event into the records of the wanted event names.
+func sinkRecords(ev interface{}, wanted map[string]bool) []sink.Record {
+	var records []sink.Record
+	add := func(name string, data interface{}) {
+		if wanted[name] {
+			records = append(records, sink.Record{Type: name, Data: data})
+		}
+	}
+	switch ev := ev.(type) {
+	case chain_manager.ChainEvent:
+		add("ChainEvent", model.Block2VO(ev.Block, false))
+		if wanted["Logs"] {
+			for _, receipt := range chain_manager.GetReceiptsFromBlock(GetExtraDB(), ev.Block) {
+				for _, log := range receipt.Logs() {
+					add("Logs", model.Log2VO(log))
+				}
+			}
+		}
+	case chain_manager.ChainSideEvent:
+		add("ChainSideEvent", model.Block2VO(ev.Block, false))
+	case chain_manager.ChainSplitEvent:
+		add("ChainSplitEvent", model.Block2VO(ev.Block, false))
+	case chain_manager.ChainHeadEvent:
+		add("ChainHeadEvent", model.Block2VO(ev.Block, false))
+	case event.TxPreEvent:
+		add("TxPreEvent", model.Transaction2VO(ev.Tx, nil))
+	case event.ChangeEvent:
+		add("ChangeEvent", model.Change2VO(*ev.Change))
+	}
+	return records
+}
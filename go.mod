@@ -6,8 +6,10 @@ require (
 	github.com/apache/thrift v0.13.0
 	github.com/astaxie/beego v1.12.3
 	github.com/cloudwego/kitex v0.1.4
+	github.com/golang/snappy v0.0.4
 	github.com/google/uuid v1.3.0
 	github.com/hashicorp/golang-lru v0.5.5-0.20210104140557-80c98217689d
+	github.com/json-iterator/go v1.1.12
 	github.com/mr-tron/base58 v1.2.0
 	github.com/rcrowley/go-metrics v0.0.0-20201227073835-cf1acfcdf475
 	github.com/syndtr/goleveldb v1.0.1-0.20210819022825-2ae1ddf74ef7
@@ -22,9 +24,7 @@ require (
 	github.com/cloudwego/netpoll v0.2.5 // indirect
 	github.com/cloudwego/netpoll-http2 v0.0.6 // indirect
 	github.com/cloudwego/thriftgo v0.1.2 // indirect
-	github.com/golang/snappy v0.0.4 // indirect
 	github.com/google/go-cmp v0.5.7 // indirect
-	github.com/json-iterator/go v1.1.12 // indirect
 	github.com/kr/pretty v0.2.1 // indirect
 	github.com/kr/text v0.2.0 // indirect
 	github.com/modern-go/concurrent v0.0.0-20180306012644-bacd9c7ef1dd // indirect
@@ -29,3 +29,208 @@ func (s *KanBanDatabaseImpl) GetAccountData(ctx context.Context, req *api.GetAcc
 func (s *KanBanDatabaseImpl) SetAccountData(ctx context.Context, req *api.SetAccountDataRequest) (resp *api.SetAccountDataResponse, err error) {
 	return handler.SetAccountData(ctx, req)
 }
+
+// SubmitTransaction implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) SubmitTransaction(ctx context.Context, req *api.SubmitTransactionRequest) (resp *api.SubmitTransactionResponse, err error) {
+	return handler.SubmitTransaction(ctx, req)
+}
+
+// SubmitTransactions implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) SubmitTransactions(ctx context.Context, req *api.SubmitTransactionsRequest) (resp *api.SubmitTransactionsResponse, err error) {
+	return handler.SubmitTransactions(ctx, req)
+}
+
+// GetTransactionByHash implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) GetTransactionByHash(ctx context.Context, req *api.GetTransactionByHashRequest) (resp *api.GetTransactionByHashResponse, err error) {
+	return handler.GetTransactionByHash(ctx, req)
+}
+
+// GetTransactionReceipt implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) GetTransactionReceipt(ctx context.Context, req *api.GetTransactionReceiptRequest) (resp *api.GetTransactionReceiptResponse, err error) {
+	return handler.GetTransactionReceipt(ctx, req)
+}
+
+// GetBlockByNumber implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) GetBlockByNumber(ctx context.Context, req *api.GetBlockByNumberRequest) (resp *api.GetBlockResponse, err error) {
+	return handler.GetBlockByNumber(ctx, req)
+}
+
+// GetBlockByHash implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) GetBlockByHash(ctx context.Context, req *api.GetBlockByHashRequest) (resp *api.GetBlockResponse, err error) {
+	return handler.GetBlockByHash(ctx, req)
+}
+
+// GetLatestBlock implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) GetLatestBlock(ctx context.Context, req *api.GetLatestBlockRequest) (resp *api.GetBlockResponse, err error) {
+	return handler.GetLatestBlock(ctx, req)
+}
+
+// TxPoolStatus implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) TxPoolStatus(ctx context.Context, req *api.TxPoolStatusRequest) (resp *api.TxPoolStatusResponse, err error) {
+	return handler.TxPoolStatus(ctx, req)
+}
+
+// TxPoolContent implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) TxPoolContent(ctx context.Context, req *api.TxPoolContentRequest) (resp *api.TxPoolContentResponse, err error) {
+	return handler.TxPoolContent(ctx, req)
+}
+
+// TxPoolInspect implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) TxPoolInspect(ctx context.Context, req *api.TxPoolInspectRequest) (resp *api.TxPoolInspectResponse, err error) {
+	return handler.TxPoolInspect(ctx, req)
+}
+
+// Call implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) Call(ctx context.Context, req *api.CallRequest) (resp *api.CallResponse, err error) {
+	return handler.Call(ctx, req)
+}
+
+// EstimateGas implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) EstimateGas(ctx context.Context, req *api.CallRequest) (resp *api.EstimateGasResponse, err error) {
+	return handler.EstimateGas(ctx, req)
+}
+
+// GetStorageAt implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) GetStorageAt(ctx context.Context, req *api.GetStorageAtRequest) (resp *api.GetStorageAtResponse, err error) {
+	return handler.GetStorageAt(ctx, req)
+}
+
+// GetAccountStorage implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) GetAccountStorage(ctx context.Context, req *api.GetAccountStorageRequest) (resp *api.GetAccountStorageResponse, err error) {
+	return handler.GetAccountStorage(ctx, req)
+}
+
+// GetAccountDataV2 implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) GetAccountDataV2(ctx context.Context, req *api.GetAccountDataRequest) (resp *api.GetAccountDataV2Response, err error) {
+	return handler.GetAccountDataV2(ctx, req)
+}
+
+// GetCode implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) GetCode(ctx context.Context, req *api.GetCodeRequest) (resp *api.GetCodeResponse, err error) {
+	return handler.GetCode(ctx, req)
+}
+
+// GetProof implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) GetProof(ctx context.Context, req *api.GetProofRequest) (resp *api.GetProofResponse, err error) {
+	return handler.GetProof(ctx, req)
+}
+
+// MultiGet implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) MultiGet(ctx context.Context, req *api.MultiGetRequest) (resp *api.MultiGetResponse, err error) {
+	return handler.MultiGet(ctx, req)
+}
+
+// MultiPut implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) MultiPut(ctx context.Context, req *api.MultiPutRequest) (resp *api.MultiPutResponse, err error) {
+	return handler.MultiPut(ctx, req)
+}
+
+// DeleteData implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) DeleteData(ctx context.Context, req *api.DeleteDataRequest) (resp *api.DeleteDataResponse, err error) {
+	return handler.DeleteData(ctx, req)
+}
+
+// Scan implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) Scan(ctx context.Context, req *api.ScanRequest) (resp *api.ScanResponse, err error) {
+	return handler.Scan(ctx, req)
+}
+
+// CreateNamespace implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) CreateNamespace(ctx context.Context, req *api.CreateNamespaceRequest) (resp *api.CreateNamespaceResponse, err error) {
+	return handler.CreateNamespace(ctx, req)
+}
+
+// ListNamespaces implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) ListNamespaces(ctx context.Context, req *api.ListNamespacesRequest) (resp *api.ListNamespacesResponse, err error) {
+	return handler.ListNamespaces(ctx, req)
+}
+
+// DropNamespace implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) DropNamespace(ctx context.Context, req *api.DropNamespaceRequest) (resp *api.DropNamespaceResponse, err error) {
+	return handler.DropNamespace(ctx, req)
+}
+
+// CreateIndex implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) CreateIndex(ctx context.Context, req *api.CreateIndexRequest) (resp *api.CreateIndexResponse, err error) {
+	return handler.CreateIndex(ctx, req)
+}
+
+// DropIndex implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) DropIndex(ctx context.Context, req *api.DropIndexRequest) (resp *api.DropIndexResponse, err error) {
+	return handler.DropIndex(ctx, req)
+}
+
+// ListIndexes implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) ListIndexes(ctx context.Context, req *api.ListIndexesRequest) (resp *api.ListIndexesResponse, err error) {
+	return handler.ListIndexes(ctx, req)
+}
+
+// QueryByIndex implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) QueryByIndex(ctx context.Context, req *api.QueryByIndexRequest) (resp *api.QueryByIndexResponse, err error) {
+	return handler.QueryByIndex(ctx, req)
+}
+
+// SubscribeChanges implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) SubscribeChanges(ctx context.Context, req *api.SubscribeChangesRequest) (resp *api.SubscribeChangesResponse, err error) {
+	return handler.SubscribeChanges(ctx, req)
+}
+
+// PutBlob implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) PutBlob(ctx context.Context, req *api.PutBlobRequest) (resp *api.PutBlobResponse, err error) {
+	return handler.PutBlob(ctx, req)
+}
+
+// GetBlob implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) GetBlob(ctx context.Context, req *api.GetBlobRequest) (resp *api.GetBlobResponse, err error) {
+	return handler.GetBlob(ctx, req)
+}
+
+// BeginTx implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) BeginTx(ctx context.Context, req *api.BeginTxRequest) (resp *api.BeginTxResponse, err error) {
+	return handler.BeginTx(ctx, req)
+}
+
+// TxGet implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) TxGet(ctx context.Context, req *api.TxGetRequest) (resp *api.TxGetResponse, err error) {
+	return handler.TxGet(ctx, req)
+}
+
+// TxPut implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) TxPut(ctx context.Context, req *api.TxPutRequest) (resp *api.TxPutResponse, err error) {
+	return handler.TxPut(ctx, req)
+}
+
+// TxDelete implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) TxDelete(ctx context.Context, req *api.TxDeleteRequest) (resp *api.TxDeleteResponse, err error) {
+	return handler.TxDelete(ctx, req)
+}
+
+// Commit implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) Commit(ctx context.Context, req *api.CommitRequest) (resp *api.CommitResponse, err error) {
+	return handler.Commit(ctx, req)
+}
+
+// Rollback implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) Rollback(ctx context.Context, req *api.RollbackRequest) (resp *api.RollbackResponse, err error) {
+	return handler.Rollback(ctx, req)
+}
+
+// CompareAndSwap implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) CompareAndSwap(ctx context.Context, req *api.CompareAndSwapRequest) (resp *api.CompareAndSwapResponse, err error) {
+	return handler.CompareAndSwap(ctx, req)
+}
+
+// GetDataAt implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) GetDataAt(ctx context.Context, req *api.GetDataAtRequest) (resp *api.GetDataAtResponse, err error) {
+	return handler.GetDataAt(ctx, req)
+}
+
+// GetDataHistory implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) GetDataHistory(ctx context.Context, req *api.GetDataHistoryRequest) (resp *api.GetDataHistoryResponse, err error) {
+	return handler.GetDataHistory(ctx, req)
+}
+
+// GetDataWithProof implements the KanBanDatabaseImpl interface.
+func (s *KanBanDatabaseImpl) GetDataWithProof(ctx context.Context, req *api.GetDataWithProofRequest) (resp *api.GetDataWithProofResponse, err error) {
+	return handler.GetDataWithProof(ctx, req)
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/kitex_gen/api"
+	"github.com/MonteCarloClub/KBD/service"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// PutBlob implements the KanBanDatabaseImpl interface.
+func PutBlob(ctx context.Context, req *api.PutBlobRequest) (resp *api.PutBlobResponse, err error) {
+	resp = &api.PutBlobResponse{}
+	id, root, size, err := service.PutBlob(ctx, req.GetUploadId(), req.Data, req.GetFinish())
+	resp.UploadId = id
+	if err != nil {
+		return resp, err
+	}
+	if root != nil {
+		hex, n := root.Hex(), int64(size)
+		resp.Root = &hex
+		resp.Size = &n
+	}
+	klog.Infof("[PutBlob]uploadId = %v,bytes = %v,resp = %v", req.GetUploadId(), len(req.Data), resp)
+	return resp, nil
+}
+
+// GetBlob implements the KanBanDatabaseImpl interface.
+func GetBlob(ctx context.Context, req *api.GetBlobRequest) (resp *api.GetBlobResponse, err error) {
+	resp = &api.GetBlobResponse{}
+	if req.GetOffset() < 0 || req.GetLength() < 0 {
+		return nil, fmt.Errorf("wrong blob request")
+	}
+	data, size, err := service.GetBlob(ctx, common.HexToHash(req.Root), uint64(req.GetOffset()), uint64(req.GetLength()))
+	if err != nil {
+		return resp, err
+	}
+	resp.Data = data
+	resp.Size = int64(size)
+	klog.Infof("[GetBlob]req = %v,bytes = %v,size = %v", req, len(data), size)
+	return resp, nil
+}
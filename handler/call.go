@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/kitex_gen/api"
+	"github.com/MonteCarloClub/KBD/service"
+	"github.com/MonteCarloClub/KBD/util"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+func callArgs(req *api.CallRequest) service.CallArgs {
+	args := service.CallArgs{
+		From: common.HexToAddress(req.GetFrom()),
+		Data: common.FromHex(req.GetData()),
+	}
+	if req.GetTo() != "" {
+		to := common.HexToAddress(req.GetTo())
+		args.To = &to
+	}
+	if req.GetGas() != "" {
+		args.Gas = common.Big(req.GetGas())
+	}
+	if req.GetGasPrice() != "" {
+		args.GasPrice = common.Big(req.GetGasPrice())
+	}
+	if req.GetValue() != "" {
+		args.Value = common.Big(req.GetValue())
+	}
+	if req.BlockNumber != nil {
+		number := uint64(req.GetBlockNumber())
+		args.BlockNumber = &number
+	}
+	return args
+}
+
+// Call implements the KanBanDatabaseImpl interface.
+func Call(ctx context.Context, req *api.CallRequest) (resp *api.CallResponse, err error) {
+	resp = &api.CallResponse{}
+	res, err := service.Call(ctx, callArgs(req))
+	if err != nil {
+		return nil, err
+	}
+	resp.Output = common.ToHex(res.Output)
+	resp.GasUsed = res.GasUsed.String()
+	if res.Err != nil {
+		vmErr := res.Err.Error()
+		resp.Error = &vmErr
+	}
+	klog.CtxInfof(ctx, "[Call]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// EstimateGas implements the KanBanDatabaseImpl interface.
+func EstimateGas(ctx context.Context, req *api.CallRequest) (resp *api.EstimateGasResponse, err error) {
+	resp = &api.EstimateGasResponse{}
+	res, err := service.EstimateGas(ctx, callArgs(req))
+	if err != nil {
+		return nil, err
+	}
+	if res.Err != nil {
+		reason := res.Err.Error()
+		resp.Error = &reason
+	} else {
+		gas := res.Gas.String()
+		resp.Gas = &gas
+	}
+	klog.CtxInfof(ctx, "[EstimateGas]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
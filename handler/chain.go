@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/kitex_gen/api"
+	"github.com/MonteCarloClub/KBD/model"
+	"github.com/MonteCarloClub/KBD/service"
+	"github.com/MonteCarloClub/KBD/util"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// GetTransactionByHash implements the KanBanDatabaseImpl interface.
+func GetTransactionByHash(ctx context.Context, req *api.GetTransactionByHashRequest) (resp *api.GetTransactionByHashResponse, err error) {
+	resp = &api.GetTransactionByHashResponse{}
+	if req.Hash == "" {
+		return nil, fmt.Errorf("wrong hash")
+	}
+
+	tx, extra := service.GetTransactionByHash(ctx, common.HexToHash(req.GetHash()))
+	if tx == nil {
+		resp.Message = "transaction not found"
+	}
+	resp.Transaction = model.Transaction2VO(tx, extra)
+	klog.CtxInfof(ctx, "[GetTransactionByHash]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// GetTransactionReceipt implements the KanBanDatabaseImpl interface.
+func GetTransactionReceipt(ctx context.Context, req *api.GetTransactionReceiptRequest) (resp *api.GetTransactionReceiptResponse, err error) {
+	resp = &api.GetTransactionReceiptResponse{}
+	if req.Hash == "" {
+		return nil, fmt.Errorf("wrong hash")
+	}
+
+	receipt, extra, gasUsed, err := service.GetTransactionReceipt(ctx, common.HexToHash(req.GetHash()))
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		resp.Message = "receipt not found"
+	}
+	resp.Receipt = model.Receipt2VO(receipt, extra, gasUsed)
+	klog.CtxInfof(ctx, "[GetTransactionReceipt]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// GetBlockByNumber implements the KanBanDatabaseImpl interface.
+func GetBlockByNumber(ctx context.Context, req *api.GetBlockByNumberRequest) (resp *api.GetBlockResponse, err error) {
+	if req.Number < 0 {
+		return nil, fmt.Errorf("wrong block number")
+	}
+
+	block, err := service.GetBlockByNumber(ctx, uint64(req.GetNumber()))
+	if err != nil {
+		return nil, err
+	}
+	resp = blockResponse(model.Block2VO(block, req.GetFullTx()))
+	klog.CtxInfof(ctx, "[GetBlockByNumber]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// GetBlockByHash implements the KanBanDatabaseImpl interface.
+func GetBlockByHash(ctx context.Context, req *api.GetBlockByHashRequest) (resp *api.GetBlockResponse, err error) {
+	if req.Hash == "" {
+		return nil, fmt.Errorf("wrong hash")
+	}
+
+	block, err := service.GetBlockByHash(ctx, common.HexToHash(req.GetHash()))
+	if err != nil {
+		return nil, err
+	}
+	resp = blockResponse(model.Block2VO(block, req.GetFullTx()))
+	klog.CtxInfof(ctx, "[GetBlockByHash]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// GetLatestBlock implements the KanBanDatabaseImpl interface.
+func GetLatestBlock(ctx context.Context, req *api.GetLatestBlockRequest) (resp *api.GetBlockResponse, err error) {
+	block, err := service.GetLatestBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp = blockResponse(model.Block2VO(block, req.GetFullTx()))
+	klog.CtxInfof(ctx, "[GetLatestBlock]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+func blockResponse(block *api.Block) *api.GetBlockResponse {
+	resp := &api.GetBlockResponse{Block: block}
+	if block == nil {
+		resp.Message = "block not found"
+	}
+	return resp
+}
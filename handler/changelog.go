@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/MonteCarloClub/KBD/kitex_gen/api"
+	"github.com/MonteCarloClub/KBD/model"
+	"github.com/MonteCarloClub/KBD/service"
+	"github.com/MonteCarloClub/KBD/util"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// SubscribeChanges implements the KanBanDatabaseImpl interface.
+func SubscribeChanges(ctx context.Context, req *api.SubscribeChangesRequest) (resp *api.SubscribeChangesResponse, err error) {
+	resp = &api.SubscribeChangesResponse{Changes: []*api.Change{}, NextSeq: req.GetFromSeq()}
+	if resp.NextSeq < 1 {
+		resp.NextSeq = 1
+	}
+	changes, err := service.SubscribeChanges(ctx, uint64(resp.NextSeq), int(req.GetLimit()), time.Duration(req.GetWaitMs())*time.Millisecond)
+	if err != nil {
+		return resp, err
+	}
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, model.Change2VO(c))
+		resp.NextSeq = int64(c.Seq) + 1
+	}
+	klog.Infof("[SubscribeChanges]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
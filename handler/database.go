@@ -2,8 +2,12 @@ package handler
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/MonteCarloClub/KBD/kitex_gen/api"
+	"github.com/MonteCarloClub/KBD/model"
+	"github.com/MonteCarloClub/KBD/model/kvtx"
 	"github.com/MonteCarloClub/KBD/service"
 	"github.com/MonteCarloClub/KBD/util"
 	"github.com/cloudwego/kitex/pkg/klog"
@@ -12,11 +16,13 @@ import (
 // GetData implements the KanBanDatabaseImpl interface.
 func GetData(ctx context.Context, req *api.GetDataRequest) (resp *api.GetDataResponse, err error) {
 	resp = &api.GetDataResponse{}
-	value, err := service.GetData(ctx, req)
+	value, version, err := service.GetData(ctx, req)
 	if err != nil {
 		return resp, err
 	}
 	resp.Value = string(value)
+	v := int64(version)
+	resp.Version = &v
 	klog.Infof("[GetData]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
 	return resp, nil
 }
@@ -24,10 +30,173 @@ func GetData(ctx context.Context, req *api.GetDataRequest) (resp *api.GetDataRes
 // PutData implements the KanBanDatabaseImpl interface.
 func PutData(ctx context.Context, req *api.PutDataRequest) (resp *api.PutDataResponse, err error) {
 	resp = &api.PutDataResponse{}
-	err = service.PutData(ctx, req)
+	version, err := service.PutData(ctx, req)
 	if err == nil {
 		resp.Success = true
+		v := int64(version)
+		resp.Version = &v
 	}
 	klog.Infof("[GetData]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
 	return resp, err
 }
+
+// CompareAndSwap implements the KanBanDatabaseImpl interface.
+func CompareAndSwap(ctx context.Context, req *api.CompareAndSwapRequest) (resp *api.CompareAndSwapResponse, err error) {
+	resp = &api.CompareAndSwapResponse{}
+	version, err := service.CompareAndSwap(ctx, req.GetNamespace(), req.Key, uint64(req.ExpectedVersion), req.Value)
+	if err == nil {
+		resp.Success = true
+		v := int64(version)
+		resp.Version = &v
+	} else if conflict, ok := err.(*kvtx.VersionErr); ok {
+		current := int64(conflict.Has)
+		message := conflict.Error()
+		resp.CurrentVersion = &current
+		resp.Message = &message
+		err = nil
+	}
+	klog.Infof("[CompareAndSwap]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, err
+}
+
+// GetDataAt implements the KanBanDatabaseImpl interface.
+func GetDataAt(ctx context.Context, req *api.GetDataAtRequest) (resp *api.GetDataAtResponse, err error) {
+	resp = &api.GetDataAtResponse{}
+	if (req.Version == nil) == (req.Timestamp == nil) {
+		return nil, fmt.Errorf("wrong revision selector")
+	}
+
+	var version *uint64
+	if req.Version != nil {
+		v := uint64(req.GetVersion())
+		version = &v
+	}
+	rev, err := service.GetDataAt(ctx, req.GetNamespace(), req.Key, version, time.Unix(0, req.GetTimestamp()*int64(time.Millisecond)))
+	switch err {
+	case nil:
+		resp.Revision = model.Revision2VO(rev)
+	case kvtx.ErrRevisionNotFound:
+		resp.Message = err.Error()
+	default:
+		return resp, err
+	}
+	klog.Infof("[GetDataAt]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// GetDataHistory implements the KanBanDatabaseImpl interface.
+func GetDataHistory(ctx context.Context, req *api.GetDataHistoryRequest) (resp *api.GetDataHistoryResponse, err error) {
+	resp = &api.GetDataHistoryResponse{Revisions: []*api.DataRevision{}}
+	revs, err := service.GetDataHistory(ctx, req.GetNamespace(), req.Key, int(req.GetLimit()))
+	if err != nil {
+		return resp, err
+	}
+	for _, rev := range revs {
+		resp.Revisions = append(resp.Revisions, model.Revision2VO(rev))
+	}
+	klog.Infof("[GetDataHistory]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// GetDataWithProof implements the KanBanDatabaseImpl interface.
+func GetDataWithProof(ctx context.Context, req *api.GetDataWithProofRequest) (resp *api.GetDataWithProofResponse, err error) {
+	resp = &api.GetDataWithProofResponse{}
+	value, found, root, proof, err := service.GetDataWithProof(ctx, req.GetNamespace(), req.Key)
+	if err != nil {
+		return resp, err
+	}
+	resp.Found = found
+	resp.Value = string(value)
+	resp.Root = root.Hex()
+	resp.Proof = model.Proof2VO(proof)
+	klog.Infof("[GetDataWithProof]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// MultiGet implements the KanBanDatabaseImpl interface.
+func MultiGet(ctx context.Context, req *api.MultiGetRequest) (resp *api.MultiGetResponse, err error) {
+	resp = &api.MultiGetResponse{}
+	values, err := service.MultiGet(ctx, req.GetNamespace(), req.Keys)
+	if err != nil {
+		return resp, err
+	}
+	resp.Values = make(map[string]string, len(values))
+	for key, value := range values {
+		resp.Values[key] = string(value)
+	}
+	klog.Infof("[MultiGet]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// MultiPut implements the KanBanDatabaseImpl interface.
+func MultiPut(ctx context.Context, req *api.MultiPutRequest) (resp *api.MultiPutResponse, err error) {
+	resp = &api.MultiPutResponse{}
+	err = service.MultiPut(ctx, req.GetNamespace(), req.Entries)
+	if err == nil {
+		resp.Success = true
+	}
+	klog.Infof("[MultiPut]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, err
+}
+
+// DeleteData implements the KanBanDatabaseImpl interface.
+func DeleteData(ctx context.Context, req *api.DeleteDataRequest) (resp *api.DeleteDataResponse, err error) {
+	resp = &api.DeleteDataResponse{}
+	err = service.DeleteData(ctx, req.GetNamespace(), req.Key)
+	if err == nil {
+		resp.Success = true
+	}
+	klog.Infof("[DeleteData]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, err
+}
+
+// Scan implements the KanBanDatabaseImpl interface.
+func Scan(ctx context.Context, req *api.ScanRequest) (resp *api.ScanResponse, err error) {
+	resp = &api.ScanResponse{Entries: []*api.KeyValue{}}
+	keys, values, next, err := service.Scan(ctx, req.GetNamespace(), req.GetPrefix(), req.GetStart(), req.GetEnd(), req.GetCursor(), int(req.GetLimit()))
+	if err != nil {
+		return resp, err
+	}
+	for i, key := range keys {
+		resp.Entries = append(resp.Entries, &api.KeyValue{Key: string(key), Value: string(values[i])})
+	}
+	if next != nil {
+		cursor := string(next)
+		resp.NextCursor = &cursor
+	}
+	klog.Infof("[Scan]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// CreateNamespace implements the KanBanDatabaseImpl interface.
+func CreateNamespace(ctx context.Context, req *api.CreateNamespaceRequest) (resp *api.CreateNamespaceResponse, err error) {
+	resp = &api.CreateNamespaceResponse{}
+	err = service.CreateNamespace(ctx, req.Name)
+	if err == nil {
+		resp.Success = true
+	}
+	klog.Infof("[CreateNamespace]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, err
+}
+
+// ListNamespaces implements the KanBanDatabaseImpl interface.
+func ListNamespaces(ctx context.Context, req *api.ListNamespacesRequest) (resp *api.ListNamespacesResponse, err error) {
+	resp = &api.ListNamespacesResponse{}
+	resp.Namespaces, err = service.ListNamespaces(ctx)
+	if err != nil {
+		return resp, err
+	}
+	klog.Infof("[ListNamespaces]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// DropNamespace implements the KanBanDatabaseImpl interface.
+func DropNamespace(ctx context.Context, req *api.DropNamespaceRequest) (resp *api.DropNamespaceResponse, err error) {
+	resp = &api.DropNamespaceResponse{}
+	err = service.DropNamespace(ctx, req.Name)
+	if err == nil {
+		resp.Success = true
+	}
+	klog.Infof("[DropNamespace]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, err
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/kitex_gen/api"
+	"github.com/MonteCarloClub/KBD/model"
+	"github.com/MonteCarloClub/KBD/service"
+	"github.com/MonteCarloClub/KBD/util"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// CreateIndex implements the KanBanDatabaseImpl interface.
+func CreateIndex(ctx context.Context, req *api.CreateIndexRequest) (resp *api.CreateIndexResponse, err error) {
+	resp = &api.CreateIndexResponse{}
+	err = service.CreateIndex(ctx, req.GetNamespace(), req.Name, req.Prefix, req.Path)
+	if err == nil {
+		resp.Success = true
+	}
+	klog.Infof("[CreateIndex]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, err
+}
+
+// DropIndex implements the KanBanDatabaseImpl interface.
+func DropIndex(ctx context.Context, req *api.DropIndexRequest) (resp *api.DropIndexResponse, err error) {
+	resp = &api.DropIndexResponse{}
+	err = service.DropIndex(ctx, req.GetNamespace(), req.Name)
+	if err == nil {
+		resp.Success = true
+	}
+	klog.Infof("[DropIndex]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, err
+}
+
+// ListIndexes implements the KanBanDatabaseImpl interface.
+func ListIndexes(ctx context.Context, req *api.ListIndexesRequest) (resp *api.ListIndexesResponse, err error) {
+	resp = &api.ListIndexesResponse{Indexes: []*api.IndexDef{}}
+	indexes, err := service.ListIndexes(ctx, req.GetNamespace())
+	if err != nil {
+		return resp, err
+	}
+	for _, idx := range indexes {
+		resp.Indexes = append(resp.Indexes, model.Index2VO(idx))
+	}
+	klog.Infof("[ListIndexes]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// QueryByIndex implements the KanBanDatabaseImpl interface.
+func QueryByIndex(ctx context.Context, req *api.QueryByIndexRequest) (resp *api.QueryByIndexResponse, err error) {
+	resp = &api.QueryByIndexResponse{Entries: []*api.KeyValue{}}
+	if req.Equals != nil && (req.From != nil || req.To != nil) {
+		return nil, fmt.Errorf("wrong index selector")
+	}
+	keys, values, next, err := service.QueryByIndex(ctx, req.GetNamespace(), req.Index, req.Equals, req.From, req.To,
+		common.Hex2Bytes(req.GetCursor()), int(req.GetLimit()))
+	if err != nil {
+		return resp, err
+	}
+	for i, key := range keys {
+		resp.Entries = append(resp.Entries, &api.KeyValue{Key: string(key), Value: string(values[i])})
+	}
+	if next != nil {
+		cursor := common.Bytes2Hex(next)
+		resp.NextCursor = &cursor
+	}
+	klog.Infof("[QueryByIndex]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
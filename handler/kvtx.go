@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/MonteCarloClub/KBD/kitex_gen/api"
+	"github.com/MonteCarloClub/KBD/model/kvtx"
+	"github.com/MonteCarloClub/KBD/service"
+	"github.com/MonteCarloClub/KBD/util"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// BeginTx implements the KanBanDatabaseImpl interface.
+func BeginTx(ctx context.Context, req *api.BeginTxRequest) (resp *api.BeginTxResponse, err error) {
+	resp = &api.BeginTxResponse{}
+	id, err := service.BeginTx(ctx)
+	if err != nil {
+		return resp, err
+	}
+	resp.TxId = int64(id)
+	klog.Infof("[BeginTx]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// TxGet implements the KanBanDatabaseImpl interface.
+func TxGet(ctx context.Context, req *api.TxGetRequest) (resp *api.TxGetResponse, err error) {
+	resp = &api.TxGetResponse{}
+	value, found, err := service.TxGet(ctx, uint64(req.TxId), req.GetNamespace(), req.Key)
+	if err != nil {
+		return resp, err
+	}
+	resp.Found = found
+	resp.Value = string(value)
+	klog.Infof("[TxGet]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// TxPut implements the KanBanDatabaseImpl interface.
+func TxPut(ctx context.Context, req *api.TxPutRequest) (resp *api.TxPutResponse, err error) {
+	resp = &api.TxPutResponse{}
+	err = service.TxPut(ctx, uint64(req.TxId), req.GetNamespace(), req.Key, req.Value)
+	if err == nil {
+		resp.Success = true
+	}
+	klog.Infof("[TxPut]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, err
+}
+
+// TxDelete implements the KanBanDatabaseImpl interface.
+func TxDelete(ctx context.Context, req *api.TxDeleteRequest) (resp *api.TxDeleteResponse, err error) {
+	resp = &api.TxDeleteResponse{}
+	err = service.TxDelete(ctx, uint64(req.TxId), req.GetNamespace(), req.Key)
+	if err == nil {
+		resp.Success = true
+	}
+	klog.Infof("[TxDelete]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, err
+}
+
+// Commit implements the KanBanDatabaseImpl interface.
+func Commit(ctx context.Context, req *api.CommitRequest) (resp *api.CommitResponse, err error) {
+	resp = &api.CommitResponse{}
+	err = service.Commit(ctx, uint64(req.TxId))
+	switch err {
+	case nil:
+		resp.Success = true
+	case kvtx.ErrTxConflict:
+		message := err.Error()
+		resp.Message = &message
+		err = nil
+	}
+	klog.Infof("[Commit]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, err
+}
+
+// Rollback implements the KanBanDatabaseImpl interface.
+func Rollback(ctx context.Context, req *api.RollbackRequest) (resp *api.RollbackResponse, err error) {
+	resp = &api.RollbackResponse{}
+	err = service.Rollback(ctx, uint64(req.TxId))
+	if err == nil {
+		resp.Success = true
+	}
+	klog.Infof("[Rollback]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, err
+}
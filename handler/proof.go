@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/kitex_gen/api"
+	"github.com/MonteCarloClub/KBD/model"
+	"github.com/MonteCarloClub/KBD/service"
+	"github.com/MonteCarloClub/KBD/util"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// GetProof implements the KanBanDatabaseImpl interface.
+func GetProof(ctx context.Context, req *api.GetProofRequest) (resp *api.GetProofResponse, err error) {
+	resp = &api.GetProofResponse{StorageProof: []*api.StorageProof{}}
+	if req.Address == "" {
+		return nil, fmt.Errorf("wrong account")
+	}
+	ref, err := stateRef(req.BlockNumber, req.BlockHash, req.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]common.Hash, len(req.StorageKeys))
+	for i, key := range req.StorageKeys {
+		keys[i] = common.HexToHash(key)
+	}
+	proof, err := service.GetProof(ctx, common.HexToAddress(req.GetAddress()), keys, ref)
+	if err != nil {
+		return nil, err
+	}
+	if proof.Account == nil {
+		resp.Message = "account not found"
+	}
+	resp.StateRoot = proof.Root.Hex()
+	resp.Account = model.StateObject2VOV2(proof.Account)
+	resp.AccountProof = model.Proof2VO(proof.Proof)
+	for _, sp := range proof.StorageProof {
+		resp.StorageProof = append(resp.StorageProof, &api.StorageProof{
+			Key:   sp.Key.Hex(),
+			Value: sp.Value.Hex(),
+			Proof: model.Proof2VO(sp.Proof),
+		})
+	}
+	klog.CtxInfof(ctx, "[GetProof]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/MonteCarloClub/KBD/common"
 	"github.com/MonteCarloClub/KBD/kitex_gen/api"
 	"github.com/MonteCarloClub/KBD/model"
 	"github.com/MonteCarloClub/KBD/service"
@@ -18,18 +19,154 @@ func GetAccountData(ctx context.Context, req *api.GetAccountDataRequest) (resp *
 		return nil, fmt.Errorf("wrong account")
 	}
 
-	obj := service.GetAccountData(ctx, req.GetAddress())
+	ref, err := stateRef(req.BlockNumber, req.BlockHash, req.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := service.GetAccountData(ctx, req.GetAddress(), ref)
+	if err != nil {
+		return nil, err
+	}
 	resp.Account = model.StateObject2VO(obj)
 	klog.CtxInfof(ctx, "[GetAccountData]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
 	return resp, nil
 }
 
+// GetAccountDataV2 implements the KanBanDatabaseImpl interface.
+func GetAccountDataV2(ctx context.Context, req *api.GetAccountDataRequest) (resp *api.GetAccountDataV2Response, err error) {
+	resp = &api.GetAccountDataV2Response{}
+	if req.Address == "" {
+		return nil, fmt.Errorf("wrong account")
+	}
+
+	ref, err := stateRef(req.BlockNumber, req.BlockHash, req.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := service.GetAccountData(ctx, req.GetAddress(), ref)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		resp.Message = "account not found"
+	}
+	resp.Account = model.StateObject2VOV2(obj)
+	klog.CtxInfof(ctx, "[GetAccountDataV2]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// GetCode implements the KanBanDatabaseImpl interface.
+func GetCode(ctx context.Context, req *api.GetCodeRequest) (resp *api.GetCodeResponse, err error) {
+	resp = &api.GetCodeResponse{}
+	if req.Address == "" {
+		return nil, fmt.Errorf("wrong account")
+	}
+
+	ref, err := stateRef(req.BlockNumber, req.BlockHash, req.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	code, found, err := service.GetCode(ctx, common.HexToAddress(req.GetAddress()), ref)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		resp.Message = "account not found"
+	}
+	resp.Code = common.ToHex(code)
+	klog.CtxInfof(ctx, "[GetCode]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
 // SetAccountData implements the KanBanDatabaseImpl interface.
 func SetAccountData(ctx context.Context, req *api.SetAccountDataRequest) (resp *api.SetAccountDataResponse, err error) {
 	resp = &api.SetAccountDataResponse{}
 	if req.Address == "" {
 		return nil, fmt.Errorf("wrong account")
 	}
-	resp.Success = service.SetAccountData(ctx, req)
+	if err := service.SetAccountData(ctx, req); err != nil {
+		return nil, err
+	}
+	resp.Success = true
+	return resp, nil
+}
+
+func stateRef(number *int64, blockHash, stateRoot *string) (ref service.StateRef, err error) {
+	set := 0
+	if number != nil {
+		n := uint64(*number)
+		ref.Number = &n
+		set++
+	}
+	if blockHash != nil {
+		hash := common.HexToHash(*blockHash)
+		ref.Hash = &hash
+		set++
+	}
+	if stateRoot != nil {
+		root := common.HexToHash(*stateRoot)
+		ref.Root = &root
+		set++
+	}
+	if set > 1 {
+		return ref, fmt.Errorf("wrong state selector")
+	}
+	return ref, nil
+}
+
+// GetStorageAt implements the KanBanDatabaseImpl interface.
+func GetStorageAt(ctx context.Context, req *api.GetStorageAtRequest) (resp *api.GetStorageAtResponse, err error) {
+	resp = &api.GetStorageAtResponse{}
+	if req.Address == "" {
+		return nil, fmt.Errorf("wrong account")
+	}
+
+	ref, err := stateRef(req.BlockNumber, req.BlockHash, req.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	value, found, err := service.GetStorageAt(ctx, common.HexToAddress(req.GetAddress()), common.HexToHash(req.GetSlot()), ref)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		resp.Message = "account not found"
+	}
+	resp.Value = value.Hex()
+	klog.CtxInfof(ctx, "[GetStorageAt]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// GetAccountStorage implements the KanBanDatabaseImpl interface.
+func GetAccountStorage(ctx context.Context, req *api.GetAccountStorageRequest) (resp *api.GetAccountStorageResponse, err error) {
+	resp = &api.GetAccountStorageResponse{Entries: []*api.StorageEntry{}}
+	if req.Address == "" {
+		return nil, fmt.Errorf("wrong account")
+	}
+
+	ref, err := stateRef(req.BlockNumber, req.BlockHash, req.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, next, found, err := service.GetAccountStorage(ctx, common.HexToAddress(req.GetAddress()), common.FromHex(req.GetCursor()), int(req.GetLimit()), ref)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		resp.Message = "account not found"
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, model.StorageEntry2VO(entry))
+	}
+	if next != nil {
+		cursor := common.ToHex(next)
+		resp.NextCursor = &cursor
+	}
+	klog.CtxInfof(ctx, "[GetAccountStorage]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
 	return resp, nil
 }
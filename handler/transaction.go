@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/kitex_gen/api"
+	"github.com/MonteCarloClub/KBD/model/kbpool"
+	"github.com/MonteCarloClub/KBD/service"
+	"github.com/MonteCarloClub/KBD/util"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+var txRejectReasons = map[error]api.TxRejectReason{
+	service.ErrInvalidRLP:        api.TxRejectReason_INVALID_RLP,
+	kbpool.ErrInvalidSender:      api.TxRejectReason_INVALID_SENDER,
+	kbpool.ErrKnownTx:            api.TxRejectReason_KNOWN_TRANSACTION,
+	kbpool.ErrNonce:              api.TxRejectReason_NONCE_TOO_LOW,
+	kbpool.ErrCheap:              api.TxRejectReason_GAS_PRICE_TOO_LOW,
+	kbpool.ErrNonExistentAccount: api.TxRejectReason_NON_EXISTENT_ACCOUNT,
+	kbpool.ErrInsufficientFunds:  api.TxRejectReason_INSUFFICIENT_FUNDS,
+	kbpool.ErrIntrinsicGas:       api.TxRejectReason_INTRINSIC_GAS_TOO_LOW,
+	kbpool.ErrGasLimit:           api.TxRejectReason_EXCEEDS_GAS_LIMIT,
+	kbpool.ErrNegativeValue:      api.TxRejectReason_NEGATIVE_VALUE,
+}
+
+func txRejectReason(err error) api.TxRejectReason {
+	if reason, ok := txRejectReasons[err]; ok {
+		return reason
+	}
+	return api.TxRejectReason_UNKNOWN
+}
+
+func submitResult(hash common.Hash, err error) *api.SubmitTransactionResponse {
+	resp := &api.SubmitTransactionResponse{}
+	if hash != (common.Hash{}) {
+		txHash := hash.Hex()
+		resp.TxHash = &txHash
+	}
+	if err != nil {
+		message := err.Error()
+		resp.Reason = api.TxRejectReasonPtr(txRejectReason(err))
+		resp.Message = &message
+		return resp
+	}
+	resp.Success = true
+	return resp
+}
+
+// SubmitTransaction implements the KanBanDatabaseImpl interface.
+func SubmitTransaction(ctx context.Context, req *api.SubmitTransactionRequest) (resp *api.SubmitTransactionResponse, err error) {
+	if len(req.RawTx) == 0 {
+		return nil, fmt.Errorf("empty transaction")
+	}
+	hash, err := service.SubmitTransaction(ctx, req.GetRawTx())
+	resp = submitResult(hash, err)
+	klog.CtxInfof(ctx, "[SubmitTransaction]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// SubmitTransactions implements the KanBanDatabaseImpl interface.
+func SubmitTransactions(ctx context.Context, req *api.SubmitTransactionsRequest) (resp *api.SubmitTransactionsResponse, err error) {
+	resp = &api.SubmitTransactionsResponse{}
+	for _, res := range service.SubmitTransactions(ctx, req.GetRawTxs()) {
+		resp.Results = append(resp.Results, submitResult(res.Hash, res.Err))
+	}
+	klog.CtxInfof(ctx, "[SubmitTransactions]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/kitex_gen/api"
+	"github.com/MonteCarloClub/KBD/model"
+	"github.com/MonteCarloClub/KBD/service"
+	"github.com/MonteCarloClub/KBD/util"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// TxPoolStatus implements the KanBanDatabaseImpl interface.
+func TxPoolStatus(ctx context.Context, req *api.TxPoolStatusRequest) (resp *api.TxPoolStatusResponse, err error) {
+	resp = &api.TxPoolStatusResponse{}
+	pending, queued := service.TxPoolStatus(ctx)
+	resp.Pending = int64(pending)
+	resp.Queued = int64(queued)
+	klog.CtxInfof(ctx, "[TxPoolStatus]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// TxPoolContent implements the KanBanDatabaseImpl interface.
+func TxPoolContent(ctx context.Context, req *api.TxPoolContentRequest) (resp *api.TxPoolContentResponse, err error) {
+	resp = &api.TxPoolContentResponse{}
+	pending, queued, reasons := service.TxPoolContent(ctx, senderFilter(req.Address))
+	resp.Pending = model.PoolContent2VO(pending, nil)
+	resp.Queued = model.PoolContent2VO(queued, reasons)
+	klog.CtxInfof(ctx, "[TxPoolContent]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+// TxPoolInspect implements the KanBanDatabaseImpl interface.
+func TxPoolInspect(ctx context.Context, req *api.TxPoolInspectRequest) (resp *api.TxPoolInspectResponse, err error) {
+	resp = &api.TxPoolInspectResponse{}
+	pending, queued, reasons := service.TxPoolContent(ctx, senderFilter(req.Address))
+	resp.Pending = model.PoolInspect2VO(pending, nil)
+	resp.Queued = model.PoolInspect2VO(queued, reasons)
+	klog.CtxInfof(ctx, "[TxPoolInspect]req = %v,resp = %v", util.ToString(req), util.ToString(resp))
+	return resp, nil
+}
+
+func senderFilter(address *string) *common.Address {
+	if address == nil || *address == "" {
+		return nil
+	}
+	addr := common.HexToAddress(*address)
+	return &addr
+}
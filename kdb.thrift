@@ -6,25 +6,315 @@ struct Account {
     3: required i64 nonce
 }
 
+struct AccountV2 {
+    1: required string address
+    2: required string balance
+    3: required i64 nonce
+    4: required string code
+    5: required string codeHash
+    6: required string storageRoot
+}
+
 struct GetDataRequest{
     1: required string key
+    2: optional string namespace
 }
 
 struct GetDataResponse{
     1: required string value
+    2: optional i64 version
 }
 
 struct PutDataRequest{
     1: required string key
     2: required string value
+    3: optional string namespace
 }
 
 struct PutDataResponse{
     1: required bool success
+    2: optional i64 version
+}
+
+struct DataRevision {
+    1: required i64 version
+    2: required i64 timestamp
+    3: required bool deleted
+    4: required string value
+}
+
+struct GetDataAtRequest {
+    1: required string key
+    2: optional i64 version
+    3: optional i64 timestamp
+    4: optional string namespace
+}
+
+struct GetDataAtResponse {
+    1: required string message
+    2: optional DataRevision revision
+}
+
+struct GetDataHistoryRequest {
+    1: required string key
+    2: optional i32 limit
+    3: optional string namespace
+}
+
+struct GetDataHistoryResponse {
+    1: required list<DataRevision> revisions
+}
+
+struct GetDataWithProofRequest {
+    1: required string key
+    2: optional string namespace
+}
+
+struct GetDataWithProofResponse {
+    1: required bool found
+    2: required string value
+    3: required string root
+    4: required list<string> proof
+}
+
+struct CompareAndSwapRequest {
+    1: required string key
+    2: required i64 expectedVersion
+    3: required string value
+    4: optional string namespace
+}
+
+struct CompareAndSwapResponse {
+    1: required bool success
+    2: optional i64 version
+    3: optional i64 currentVersion
+    4: optional string message
+}
+
+struct KeyValue {
+    1: required string key
+    2: required string value
+}
+
+struct MultiGetRequest {
+    1: required list<string> keys
+    2: optional string namespace
+}
+
+struct MultiGetResponse {
+    1: required map<string,string> values
+}
+
+struct MultiPutRequest {
+    1: required list<KeyValue> entries
+    2: optional string namespace
+}
+
+struct MultiPutResponse {
+    1: required bool success
+}
+
+struct DeleteDataRequest {
+    1: required string key
+    2: optional string namespace
+}
+
+struct DeleteDataResponse {
+    1: required bool success
+}
+
+struct ScanRequest {
+    1: optional string prefix
+    2: optional string start
+    3: optional string end
+    4: optional i32 limit
+    5: optional string cursor
+    6: optional string namespace
+}
+
+struct ScanResponse {
+    1: required list<KeyValue> entries
+    2: optional string nextCursor
+}
+
+struct CreateNamespaceRequest {
+    1: required string name
+}
+
+struct CreateNamespaceResponse {
+    1: required bool success
+}
+
+struct ListNamespacesRequest {
+}
+
+struct ListNamespacesResponse {
+    1: required list<string> namespaces
+}
+
+struct DropNamespaceRequest {
+    1: required string name
+}
+
+struct DropNamespaceResponse {
+    1: required bool success
+}
+
+struct IndexDef {
+    1: required string name
+    2: required string prefix
+    3: required string path
+}
+
+struct CreateIndexRequest {
+    1: required string name
+    2: required string prefix
+    3: required string path
+    4: optional string namespace
+}
+
+struct CreateIndexResponse {
+    1: required bool success
+}
+
+struct DropIndexRequest {
+    1: required string name
+    2: optional string namespace
+}
+
+struct DropIndexResponse {
+    1: required bool success
+}
+
+struct ListIndexesRequest {
+    1: optional string namespace
+}
+
+struct ListIndexesResponse {
+    1: required list<IndexDef> indexes
+}
+
+struct QueryByIndexRequest {
+    1: required string index
+    2: optional string equals
+    3: optional string from
+    4: optional string to
+    5: optional i32 limit
+    6: optional string cursor
+    7: optional string namespace
+}
+
+struct QueryByIndexResponse {
+    1: required list<KeyValue> entries
+    2: optional string nextCursor
+}
+
+struct Change {
+    1: required i64 seq
+    2: required i64 timestamp
+    3: required string kind
+    4: required string key
+    5: optional string namespace
+    6: optional string value
+    7: required bool deleted
+    8: optional i64 version
+}
+
+struct SubscribeChangesRequest {
+    1: optional i64 fromSeq
+    2: optional i32 limit
+    3: optional i32 waitMs
+}
+
+struct SubscribeChangesResponse {
+    1: required list<Change> changes
+    2: required i64 nextSeq
+}
+
+struct PutBlobRequest {
+    1: optional string uploadId
+    2: optional binary data
+    3: optional bool finish
+}
+
+struct PutBlobResponse {
+    1: required string uploadId
+    2: optional string root
+    3: optional i64 size
+}
+
+struct GetBlobRequest {
+    1: required string root
+    2: optional i64 offset
+    3: optional i64 length
+}
+
+struct GetBlobResponse {
+    1: required binary data
+    2: required i64 size
+}
+
+struct BeginTxRequest {
+}
+
+struct BeginTxResponse {
+    1: required i64 txId
+}
+
+struct TxGetRequest {
+    1: required i64 txId
+    2: required string key
+    3: optional string namespace
+}
+
+struct TxGetResponse {
+    1: required bool found
+    2: required string value
+}
+
+struct TxPutRequest {
+    1: required i64 txId
+    2: required string key
+    3: required string value
+    4: optional string namespace
+}
+
+struct TxPutResponse {
+    1: required bool success
+}
+
+struct TxDeleteRequest {
+    1: required i64 txId
+    2: required string key
+    3: optional string namespace
+}
+
+struct TxDeleteResponse {
+    1: required bool success
+}
+
+struct CommitRequest {
+    1: required i64 txId
+}
+
+struct CommitResponse {
+    1: required bool success
+    2: optional string message
+}
+
+struct RollbackRequest {
+    1: required i64 txId
+}
+
+struct RollbackResponse {
+    1: required bool success
 }
 
 struct GetAccountDataRequest {
     1: required string address
+    2: optional i64 blockNumber
+    3: optional string blockHash
+    4: optional string stateRoot
 }
 
 struct GetAccountDataResponse {
@@ -32,6 +322,23 @@ struct GetAccountDataResponse {
     2: optional Account account
 }
 
+struct GetAccountDataV2Response {
+    1: required string message
+    2: optional AccountV2 account
+}
+
+struct GetCodeRequest {
+    1: required string address
+    2: optional i64 blockNumber
+    3: optional string blockHash
+    4: optional string stateRoot
+}
+
+struct GetCodeResponse {
+    1: required string message
+    2: required string code
+}
+
 struct SetAccountDataRequest {
     1: required string address
     2: optional string balance
@@ -45,9 +352,296 @@ struct SetAccountDataResponse {
     2: required bool success
 }
 
+enum TxRejectReason {
+    NONE = 0
+    UNKNOWN = 1
+    INVALID_RLP = 2
+    INVALID_SENDER = 3
+    KNOWN_TRANSACTION = 4
+    NONCE_TOO_LOW = 5
+    GAS_PRICE_TOO_LOW = 6
+    NON_EXISTENT_ACCOUNT = 7
+    INSUFFICIENT_FUNDS = 8
+    INTRINSIC_GAS_TOO_LOW = 9
+    EXCEEDS_GAS_LIMIT = 10
+    NEGATIVE_VALUE = 11
+}
+
+struct SubmitTransactionRequest {
+    1: required binary rawTx
+}
+
+struct SubmitTransactionResponse {
+    1: required bool success
+    2: optional string txHash
+    3: optional TxRejectReason reason
+    4: optional string message
+}
+
+struct SubmitTransactionsRequest {
+    1: required list<binary> rawTxs
+}
+
+struct SubmitTransactionsResponse {
+    1: required list<SubmitTransactionResponse> results
+}
+
+struct Log {
+    1: required string address
+    2: required list<string> topics
+    3: required string data
+    4: required i64 blockNumber
+    5: required string txHash
+    6: required i64 txIndex
+    7: required string blockHash
+    8: required i64 logIndex
+}
+
+struct Transaction {
+    1: required string hash
+    2: required i64 nonce
+    3: optional string from
+    4: optional string to
+    5: required string value
+    6: required string gas
+    7: required string gasPrice
+    8: required string input
+    9: optional string blockHash
+    10: optional i64 blockNumber
+    11: optional i64 transactionIndex
+}
+
+struct Receipt {
+    1: required string txHash
+    2: required string blockHash
+    3: required i64 blockNumber
+    4: required i64 transactionIndex
+    5: required string cumulativeGasUsed
+    6: optional string gasUsed
+    7: optional string contractAddress
+    8: required list<Log> logs
+    9: required string logsBloom
+    10: required string postState
+}
+
+struct Block {
+    1: required string hash
+    2: required string parentHash
+    3: required i64 number
+    4: required string nonce
+    5: required string mixDigest
+    6: required string coinbase
+    7: required string stateRoot
+    8: required string transactionsRoot
+    9: required string receiptsRoot
+    10: required string uncleHash
+    11: required string logsBloom
+    12: required string difficulty
+    13: optional string totalDifficulty
+    14: required string gasLimit
+    15: required string gasUsed
+    16: required i64 timestamp
+    17: required string extraData
+    18: required list<string> transactionHashes
+    19: optional list<Transaction> transactions
+}
+
+struct GetTransactionByHashRequest {
+    1: required string hash
+}
+
+struct GetTransactionByHashResponse {
+    1: required string message
+    2: optional Transaction transaction
+}
+
+struct GetTransactionReceiptRequest {
+    1: required string hash
+}
+
+struct GetTransactionReceiptResponse {
+    1: required string message
+    2: optional Receipt receipt
+}
+
+struct GetBlockByNumberRequest {
+    1: required i64 number
+    2: optional bool fullTx
+}
+
+struct GetBlockByHashRequest {
+    1: required string hash
+    2: optional bool fullTx
+}
+
+struct GetLatestBlockRequest {
+    1: optional bool fullTx
+}
+
+struct GetBlockResponse {
+    1: required string message
+    2: optional Block block
+}
+
+enum QueuedTxReason {
+    PROMOTABLE = 0
+    NONCE_GAP = 1
+    AWAITING_FUNDS = 2
+    DROPPED = 3
+}
+
+struct PoolTransaction {
+    1: required Transaction transaction
+    2: optional QueuedTxReason reason
+}
+
+struct TxPoolStatusRequest {
+}
+
+struct TxPoolStatusResponse {
+    1: required i64 pending
+    2: required i64 queued
+}
+
+struct TxPoolContentRequest {
+    1: optional string address
+}
+
+struct TxPoolContentResponse {
+    1: required map<string,list<PoolTransaction>> pending
+    2: required map<string,list<PoolTransaction>> queued
+}
+
+struct TxPoolInspectRequest {
+    1: optional string address
+}
+
+struct TxPoolInspectResponse {
+    1: required map<string,list<string>> pending
+    2: required map<string,list<string>> queued
+}
+
+struct CallRequest {
+    1: optional string from
+    2: optional string to
+    3: optional string data
+    4: optional string gas
+    5: optional string gasPrice
+    6: optional string value
+    7: optional i64 blockNumber
+}
+
+struct CallResponse {
+    1: required string output
+    2: required string gasUsed
+    3: optional string error
+}
+
+struct GetStorageAtRequest {
+    1: required string address
+    2: required string slot
+    3: optional i64 blockNumber
+    4: optional string blockHash
+    5: optional string stateRoot
+}
+
+struct GetStorageAtResponse {
+    1: required string message
+    2: required string value
+}
+
+struct StorageEntry {
+    1: required string key
+    2: optional string slot
+    3: required string value
+}
+
+struct GetAccountStorageRequest {
+    1: required string address
+    2: optional string cursor
+    3: optional i32 limit
+    4: optional i64 blockNumber
+    5: optional string blockHash
+    6: optional string stateRoot
+}
+
+struct GetAccountStorageResponse {
+    1: required string message
+    2: required list<StorageEntry> entries
+    3: optional string nextCursor
+}
+
+struct StorageProof {
+    1: required string key
+    2: required string value
+    3: required list<string> proof
+}
+
+struct GetProofRequest {
+    1: required string address
+    2: optional list<string> storageKeys
+    3: optional i64 blockNumber
+    4: optional string blockHash
+    5: optional string stateRoot
+}
+
+struct GetProofResponse {
+    1: required string message
+    2: required string stateRoot
+    3: optional AccountV2 account
+    4: required list<string> accountProof
+    5: required list<StorageProof> storageProof
+}
+
+struct EstimateGasResponse {
+    1: optional string gas
+    2: optional string error
+}
+
 service kanBanDatabase {
     GetDataResponse GetData(1: GetDataRequest req)
     PutDataResponse PutData(1: PutDataRequest req)
+    CompareAndSwapResponse CompareAndSwap(1: CompareAndSwapRequest req)
+    GetDataAtResponse GetDataAt(1: GetDataAtRequest req)
+    GetDataHistoryResponse GetDataHistory(1: GetDataHistoryRequest req)
+    GetDataWithProofResponse GetDataWithProof(1: GetDataWithProofRequest req)
+    MultiGetResponse MultiGet(1: MultiGetRequest req)
+    MultiPutResponse MultiPut(1: MultiPutRequest req)
+    DeleteDataResponse DeleteData(1: DeleteDataRequest req)
+    ScanResponse Scan(1: ScanRequest req)
+    CreateNamespaceResponse CreateNamespace(1: CreateNamespaceRequest req)
+    ListNamespacesResponse ListNamespaces(1: ListNamespacesRequest req)
+    DropNamespaceResponse DropNamespace(1: DropNamespaceRequest req)
+    CreateIndexResponse CreateIndex(1: CreateIndexRequest req)
+    DropIndexResponse DropIndex(1: DropIndexRequest req)
+    ListIndexesResponse ListIndexes(1: ListIndexesRequest req)
+    QueryByIndexResponse QueryByIndex(1: QueryByIndexRequest req)
+    SubscribeChangesResponse SubscribeChanges(1: SubscribeChangesRequest req)
+    PutBlobResponse PutBlob(1: PutBlobRequest req)
+    GetBlobResponse GetBlob(1: GetBlobRequest req)
+    BeginTxResponse BeginTx(1: BeginTxRequest req)
+    TxGetResponse TxGet(1: TxGetRequest req)
+    TxPutResponse TxPut(1: TxPutRequest req)
+    TxDeleteResponse TxDelete(1: TxDeleteRequest req)
+    CommitResponse Commit(1: CommitRequest req)
+    RollbackResponse Rollback(1: RollbackRequest req)
     GetAccountDataResponse GetAccountData(1:  GetAccountDataRequest req)
+    GetAccountDataV2Response GetAccountDataV2(1: GetAccountDataRequest req)
+    GetCodeResponse GetCode(1: GetCodeRequest req)
     SetAccountDataResponse SetAccountData(1:  SetAccountDataRequest req)
+    SubmitTransactionResponse SubmitTransaction(1: SubmitTransactionRequest req)
+    SubmitTransactionsResponse SubmitTransactions(1: SubmitTransactionsRequest req)
+    GetTransactionByHashResponse GetTransactionByHash(1: GetTransactionByHashRequest req)
+    GetTransactionReceiptResponse GetTransactionReceipt(1: GetTransactionReceiptRequest req)
+    GetBlockResponse GetBlockByNumber(1: GetBlockByNumberRequest req)
+    GetBlockResponse GetBlockByHash(1: GetBlockByHashRequest req)
+    GetBlockResponse GetLatestBlock(1: GetLatestBlockRequest req)
+    TxPoolStatusResponse TxPoolStatus(1: TxPoolStatusRequest req)
+    TxPoolContentResponse TxPoolContent(1: TxPoolContentRequest req)
+    TxPoolInspectResponse TxPoolInspect(1: TxPoolInspectRequest req)
+    CallResponse Call(1: CallRequest req)
+    EstimateGasResponse EstimateGas(1: CallRequest req)
+    GetStorageAtResponse GetStorageAt(1: GetStorageAtRequest req)
+    GetAccountStorageResponse GetAccountStorage(1: GetAccountStorageRequest req)
+    GetProofResponse GetProof(1: GetProofRequest req)
 }
\ No newline at end of file
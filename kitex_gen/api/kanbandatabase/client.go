@@ -13,8 +13,49 @@ import (
 type Client interface {
 	GetData(ctx context.Context, req *api.GetDataRequest, callOptions ...callopt.Option) (r *api.GetDataResponse, err error)
 	PutData(ctx context.Context, req *api.PutDataRequest, callOptions ...callopt.Option) (r *api.PutDataResponse, err error)
+	CompareAndSwap(ctx context.Context, req *api.CompareAndSwapRequest, callOptions ...callopt.Option) (r *api.CompareAndSwapResponse, err error)
+	GetDataAt(ctx context.Context, req *api.GetDataAtRequest, callOptions ...callopt.Option) (r *api.GetDataAtResponse, err error)
+	GetDataHistory(ctx context.Context, req *api.GetDataHistoryRequest, callOptions ...callopt.Option) (r *api.GetDataHistoryResponse, err error)
+	GetDataWithProof(ctx context.Context, req *api.GetDataWithProofRequest, callOptions ...callopt.Option) (r *api.GetDataWithProofResponse, err error)
+	MultiGet(ctx context.Context, req *api.MultiGetRequest, callOptions ...callopt.Option) (r *api.MultiGetResponse, err error)
+	MultiPut(ctx context.Context, req *api.MultiPutRequest, callOptions ...callopt.Option) (r *api.MultiPutResponse, err error)
+	DeleteData(ctx context.Context, req *api.DeleteDataRequest, callOptions ...callopt.Option) (r *api.DeleteDataResponse, err error)
+	Scan(ctx context.Context, req *api.ScanRequest, callOptions ...callopt.Option) (r *api.ScanResponse, err error)
+	CreateNamespace(ctx context.Context, req *api.CreateNamespaceRequest, callOptions ...callopt.Option) (r *api.CreateNamespaceResponse, err error)
+	ListNamespaces(ctx context.Context, req *api.ListNamespacesRequest, callOptions ...callopt.Option) (r *api.ListNamespacesResponse, err error)
+	DropNamespace(ctx context.Context, req *api.DropNamespaceRequest, callOptions ...callopt.Option) (r *api.DropNamespaceResponse, err error)
+	CreateIndex(ctx context.Context, req *api.CreateIndexRequest, callOptions ...callopt.Option) (r *api.CreateIndexResponse, err error)
+	DropIndex(ctx context.Context, req *api.DropIndexRequest, callOptions ...callopt.Option) (r *api.DropIndexResponse, err error)
+	ListIndexes(ctx context.Context, req *api.ListIndexesRequest, callOptions ...callopt.Option) (r *api.ListIndexesResponse, err error)
+	QueryByIndex(ctx context.Context, req *api.QueryByIndexRequest, callOptions ...callopt.Option) (r *api.QueryByIndexResponse, err error)
+	SubscribeChanges(ctx context.Context, req *api.SubscribeChangesRequest, callOptions ...callopt.Option) (r *api.SubscribeChangesResponse, err error)
+	PutBlob(ctx context.Context, req *api.PutBlobRequest, callOptions ...callopt.Option) (r *api.PutBlobResponse, err error)
+	GetBlob(ctx context.Context, req *api.GetBlobRequest, callOptions ...callopt.Option) (r *api.GetBlobResponse, err error)
+	BeginTx(ctx context.Context, req *api.BeginTxRequest, callOptions ...callopt.Option) (r *api.BeginTxResponse, err error)
+	TxGet(ctx context.Context, req *api.TxGetRequest, callOptions ...callopt.Option) (r *api.TxGetResponse, err error)
+	TxPut(ctx context.Context, req *api.TxPutRequest, callOptions ...callopt.Option) (r *api.TxPutResponse, err error)
+	TxDelete(ctx context.Context, req *api.TxDeleteRequest, callOptions ...callopt.Option) (r *api.TxDeleteResponse, err error)
+	Commit(ctx context.Context, req *api.CommitRequest, callOptions ...callopt.Option) (r *api.CommitResponse, err error)
+	Rollback(ctx context.Context, req *api.RollbackRequest, callOptions ...callopt.Option) (r *api.RollbackResponse, err error)
 	GetAccountData(ctx context.Context, req *api.GetAccountDataRequest, callOptions ...callopt.Option) (r *api.GetAccountDataResponse, err error)
+	GetAccountDataV2(ctx context.Context, req *api.GetAccountDataRequest, callOptions ...callopt.Option) (r *api.GetAccountDataV2Response, err error)
+	GetCode(ctx context.Context, req *api.GetCodeRequest, callOptions ...callopt.Option) (r *api.GetCodeResponse, err error)
 	SetAccountData(ctx context.Context, req *api.SetAccountDataRequest, callOptions ...callopt.Option) (r *api.SetAccountDataResponse, err error)
+	SubmitTransaction(ctx context.Context, req *api.SubmitTransactionRequest, callOptions ...callopt.Option) (r *api.SubmitTransactionResponse, err error)
+	SubmitTransactions(ctx context.Context, req *api.SubmitTransactionsRequest, callOptions ...callopt.Option) (r *api.SubmitTransactionsResponse, err error)
+	GetTransactionByHash(ctx context.Context, req *api.GetTransactionByHashRequest, callOptions ...callopt.Option) (r *api.GetTransactionByHashResponse, err error)
+	GetTransactionReceipt(ctx context.Context, req *api.GetTransactionReceiptRequest, callOptions ...callopt.Option) (r *api.GetTransactionReceiptResponse, err error)
+	GetBlockByNumber(ctx context.Context, req *api.GetBlockByNumberRequest, callOptions ...callopt.Option) (r *api.GetBlockResponse, err error)
+	GetBlockByHash(ctx context.Context, req *api.GetBlockByHashRequest, callOptions ...callopt.Option) (r *api.GetBlockResponse, err error)
+	GetLatestBlock(ctx context.Context, req *api.GetLatestBlockRequest, callOptions ...callopt.Option) (r *api.GetBlockResponse, err error)
+	TxPoolStatus(ctx context.Context, req *api.TxPoolStatusRequest, callOptions ...callopt.Option) (r *api.TxPoolStatusResponse, err error)
+	TxPoolContent(ctx context.Context, req *api.TxPoolContentRequest, callOptions ...callopt.Option) (r *api.TxPoolContentResponse, err error)
+	TxPoolInspect(ctx context.Context, req *api.TxPoolInspectRequest, callOptions ...callopt.Option) (r *api.TxPoolInspectResponse, err error)
+	Call(ctx context.Context, req *api.CallRequest, callOptions ...callopt.Option) (r *api.CallResponse, err error)
+	EstimateGas(ctx context.Context, req *api.CallRequest, callOptions ...callopt.Option) (r *api.EstimateGasResponse, err error)
+	GetStorageAt(ctx context.Context, req *api.GetStorageAtRequest, callOptions ...callopt.Option) (r *api.GetStorageAtResponse, err error)
+	GetAccountStorage(ctx context.Context, req *api.GetAccountStorageRequest, callOptions ...callopt.Option) (r *api.GetAccountStorageResponse, err error)
+	GetProof(ctx context.Context, req *api.GetProofRequest, callOptions ...callopt.Option) (r *api.GetProofResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -56,12 +97,217 @@ func (p *kKanBanDatabaseClient) PutData(ctx context.Context, req *api.PutDataReq
 	return p.kClient.PutData(ctx, req)
 }
 
+func (p *kKanBanDatabaseClient) CompareAndSwap(ctx context.Context, req *api.CompareAndSwapRequest, callOptions ...callopt.Option) (r *api.CompareAndSwapResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CompareAndSwap(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) GetDataAt(ctx context.Context, req *api.GetDataAtRequest, callOptions ...callopt.Option) (r *api.GetDataAtResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetDataAt(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) GetDataHistory(ctx context.Context, req *api.GetDataHistoryRequest, callOptions ...callopt.Option) (r *api.GetDataHistoryResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetDataHistory(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) GetDataWithProof(ctx context.Context, req *api.GetDataWithProofRequest, callOptions ...callopt.Option) (r *api.GetDataWithProofResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetDataWithProof(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) MultiGet(ctx context.Context, req *api.MultiGetRequest, callOptions ...callopt.Option) (r *api.MultiGetResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MultiGet(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) MultiPut(ctx context.Context, req *api.MultiPutRequest, callOptions ...callopt.Option) (r *api.MultiPutResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MultiPut(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) DeleteData(ctx context.Context, req *api.DeleteDataRequest, callOptions ...callopt.Option) (r *api.DeleteDataResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteData(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) Scan(ctx context.Context, req *api.ScanRequest, callOptions ...callopt.Option) (r *api.ScanResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Scan(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) CreateNamespace(ctx context.Context, req *api.CreateNamespaceRequest, callOptions ...callopt.Option) (r *api.CreateNamespaceResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateNamespace(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) ListNamespaces(ctx context.Context, req *api.ListNamespacesRequest, callOptions ...callopt.Option) (r *api.ListNamespacesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListNamespaces(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) DropNamespace(ctx context.Context, req *api.DropNamespaceRequest, callOptions ...callopt.Option) (r *api.DropNamespaceResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DropNamespace(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) CreateIndex(ctx context.Context, req *api.CreateIndexRequest, callOptions ...callopt.Option) (r *api.CreateIndexResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateIndex(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) DropIndex(ctx context.Context, req *api.DropIndexRequest, callOptions ...callopt.Option) (r *api.DropIndexResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DropIndex(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) ListIndexes(ctx context.Context, req *api.ListIndexesRequest, callOptions ...callopt.Option) (r *api.ListIndexesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListIndexes(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) QueryByIndex(ctx context.Context, req *api.QueryByIndexRequest, callOptions ...callopt.Option) (r *api.QueryByIndexResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.QueryByIndex(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) SubscribeChanges(ctx context.Context, req *api.SubscribeChangesRequest, callOptions ...callopt.Option) (r *api.SubscribeChangesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubscribeChanges(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) PutBlob(ctx context.Context, req *api.PutBlobRequest, callOptions ...callopt.Option) (r *api.PutBlobResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PutBlob(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) GetBlob(ctx context.Context, req *api.GetBlobRequest, callOptions ...callopt.Option) (r *api.GetBlobResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetBlob(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) BeginTx(ctx context.Context, req *api.BeginTxRequest, callOptions ...callopt.Option) (r *api.BeginTxResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.BeginTx(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) TxGet(ctx context.Context, req *api.TxGetRequest, callOptions ...callopt.Option) (r *api.TxGetResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TxGet(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) TxPut(ctx context.Context, req *api.TxPutRequest, callOptions ...callopt.Option) (r *api.TxPutResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TxPut(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) TxDelete(ctx context.Context, req *api.TxDeleteRequest, callOptions ...callopt.Option) (r *api.TxDeleteResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TxDelete(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) Commit(ctx context.Context, req *api.CommitRequest, callOptions ...callopt.Option) (r *api.CommitResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Commit(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) Rollback(ctx context.Context, req *api.RollbackRequest, callOptions ...callopt.Option) (r *api.RollbackResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Rollback(ctx, req)
+}
+
 func (p *kKanBanDatabaseClient) GetAccountData(ctx context.Context, req *api.GetAccountDataRequest, callOptions ...callopt.Option) (r *api.GetAccountDataResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetAccountData(ctx, req)
 }
 
+func (p *kKanBanDatabaseClient) GetAccountDataV2(ctx context.Context, req *api.GetAccountDataRequest, callOptions ...callopt.Option) (r *api.GetAccountDataV2Response, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetAccountDataV2(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) GetCode(ctx context.Context, req *api.GetCodeRequest, callOptions ...callopt.Option) (r *api.GetCodeResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetCode(ctx, req)
+}
+
 func (p *kKanBanDatabaseClient) SetAccountData(ctx context.Context, req *api.SetAccountDataRequest, callOptions ...callopt.Option) (r *api.SetAccountDataResponse, err error) {
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.SetAccountData(ctx, req)
 }
+
+func (p *kKanBanDatabaseClient) SubmitTransaction(ctx context.Context, req *api.SubmitTransactionRequest, callOptions ...callopt.Option) (r *api.SubmitTransactionResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubmitTransaction(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) SubmitTransactions(ctx context.Context, req *api.SubmitTransactionsRequest, callOptions ...callopt.Option) (r *api.SubmitTransactionsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SubmitTransactions(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) GetTransactionByHash(ctx context.Context, req *api.GetTransactionByHashRequest, callOptions ...callopt.Option) (r *api.GetTransactionByHashResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetTransactionByHash(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) GetTransactionReceipt(ctx context.Context, req *api.GetTransactionReceiptRequest, callOptions ...callopt.Option) (r *api.GetTransactionReceiptResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetTransactionReceipt(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) GetBlockByNumber(ctx context.Context, req *api.GetBlockByNumberRequest, callOptions ...callopt.Option) (r *api.GetBlockResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetBlockByNumber(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) GetBlockByHash(ctx context.Context, req *api.GetBlockByHashRequest, callOptions ...callopt.Option) (r *api.GetBlockResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetBlockByHash(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) GetLatestBlock(ctx context.Context, req *api.GetLatestBlockRequest, callOptions ...callopt.Option) (r *api.GetBlockResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetLatestBlock(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) TxPoolStatus(ctx context.Context, req *api.TxPoolStatusRequest, callOptions ...callopt.Option) (r *api.TxPoolStatusResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TxPoolStatus(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) TxPoolContent(ctx context.Context, req *api.TxPoolContentRequest, callOptions ...callopt.Option) (r *api.TxPoolContentResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TxPoolContent(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) TxPoolInspect(ctx context.Context, req *api.TxPoolInspectRequest, callOptions ...callopt.Option) (r *api.TxPoolInspectResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TxPoolInspect(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) Call(ctx context.Context, req *api.CallRequest, callOptions ...callopt.Option) (r *api.CallResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.Call(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) EstimateGas(ctx context.Context, req *api.CallRequest, callOptions ...callopt.Option) (r *api.EstimateGasResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.EstimateGas(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) GetStorageAt(ctx context.Context, req *api.GetStorageAtRequest, callOptions ...callopt.Option) (r *api.GetStorageAtResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetStorageAt(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) GetAccountStorage(ctx context.Context, req *api.GetAccountStorageRequest, callOptions ...callopt.Option) (r *api.GetAccountStorageResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetAccountStorage(ctx, req)
+}
+
+func (p *kKanBanDatabaseClient) GetProof(ctx context.Context, req *api.GetProofRequest, callOptions ...callopt.Option) (r *api.GetProofResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetProof(ctx, req)
+}
@@ -19,10 +19,51 @@ func NewServiceInfo() *kitex.ServiceInfo {
 	serviceName := "kanBanDatabase"
 	handlerType := (*api.KanBanDatabase)(nil)
 	methods := map[string]kitex.MethodInfo{
-		"GetData":        kitex.NewMethodInfo(getDataHandler, newKanBanDatabaseGetDataArgs, newKanBanDatabaseGetDataResult, false),
-		"PutData":        kitex.NewMethodInfo(putDataHandler, newKanBanDatabasePutDataArgs, newKanBanDatabasePutDataResult, false),
-		"GetAccountData": kitex.NewMethodInfo(getAccountDataHandler, newKanBanDatabaseGetAccountDataArgs, newKanBanDatabaseGetAccountDataResult, false),
-		"SetAccountData": kitex.NewMethodInfo(setAccountDataHandler, newKanBanDatabaseSetAccountDataArgs, newKanBanDatabaseSetAccountDataResult, false),
+		"GetData":               kitex.NewMethodInfo(getDataHandler, newKanBanDatabaseGetDataArgs, newKanBanDatabaseGetDataResult, false),
+		"PutData":               kitex.NewMethodInfo(putDataHandler, newKanBanDatabasePutDataArgs, newKanBanDatabasePutDataResult, false),
+		"CompareAndSwap":        kitex.NewMethodInfo(compareAndSwapHandler, newKanBanDatabaseCompareAndSwapArgs, newKanBanDatabaseCompareAndSwapResult, false),
+		"GetDataAt":             kitex.NewMethodInfo(getDataAtHandler, newKanBanDatabaseGetDataAtArgs, newKanBanDatabaseGetDataAtResult, false),
+		"GetDataHistory":        kitex.NewMethodInfo(getDataHistoryHandler, newKanBanDatabaseGetDataHistoryArgs, newKanBanDatabaseGetDataHistoryResult, false),
+		"GetDataWithProof":      kitex.NewMethodInfo(getDataWithProofHandler, newKanBanDatabaseGetDataWithProofArgs, newKanBanDatabaseGetDataWithProofResult, false),
+		"MultiGet":              kitex.NewMethodInfo(multiGetHandler, newKanBanDatabaseMultiGetArgs, newKanBanDatabaseMultiGetResult, false),
+		"MultiPut":              kitex.NewMethodInfo(multiPutHandler, newKanBanDatabaseMultiPutArgs, newKanBanDatabaseMultiPutResult, false),
+		"DeleteData":            kitex.NewMethodInfo(deleteDataHandler, newKanBanDatabaseDeleteDataArgs, newKanBanDatabaseDeleteDataResult, false),
+		"Scan":                  kitex.NewMethodInfo(scanHandler, newKanBanDatabaseScanArgs, newKanBanDatabaseScanResult, false),
+		"CreateNamespace":       kitex.NewMethodInfo(createNamespaceHandler, newKanBanDatabaseCreateNamespaceArgs, newKanBanDatabaseCreateNamespaceResult, false),
+		"ListNamespaces":        kitex.NewMethodInfo(listNamespacesHandler, newKanBanDatabaseListNamespacesArgs, newKanBanDatabaseListNamespacesResult, false),
+		"DropNamespace":         kitex.NewMethodInfo(dropNamespaceHandler, newKanBanDatabaseDropNamespaceArgs, newKanBanDatabaseDropNamespaceResult, false),
+		"CreateIndex":           kitex.NewMethodInfo(createIndexHandler, newKanBanDatabaseCreateIndexArgs, newKanBanDatabaseCreateIndexResult, false),
+		"DropIndex":             kitex.NewMethodInfo(dropIndexHandler, newKanBanDatabaseDropIndexArgs, newKanBanDatabaseDropIndexResult, false),
+		"ListIndexes":           kitex.NewMethodInfo(listIndexesHandler, newKanBanDatabaseListIndexesArgs, newKanBanDatabaseListIndexesResult, false),
+		"QueryByIndex":          kitex.NewMethodInfo(queryByIndexHandler, newKanBanDatabaseQueryByIndexArgs, newKanBanDatabaseQueryByIndexResult, false),
+		"SubscribeChanges":      kitex.NewMethodInfo(subscribeChangesHandler, newKanBanDatabaseSubscribeChangesArgs, newKanBanDatabaseSubscribeChangesResult, false),
+		"PutBlob":               kitex.NewMethodInfo(putBlobHandler, newKanBanDatabasePutBlobArgs, newKanBanDatabasePutBlobResult, false),
+		"GetBlob":               kitex.NewMethodInfo(getBlobHandler, newKanBanDatabaseGetBlobArgs, newKanBanDatabaseGetBlobResult, false),
+		"BeginTx":               kitex.NewMethodInfo(beginTxHandler, newKanBanDatabaseBeginTxArgs, newKanBanDatabaseBeginTxResult, false),
+		"TxGet":                 kitex.NewMethodInfo(txGetHandler, newKanBanDatabaseTxGetArgs, newKanBanDatabaseTxGetResult, false),
+		"TxPut":                 kitex.NewMethodInfo(txPutHandler, newKanBanDatabaseTxPutArgs, newKanBanDatabaseTxPutResult, false),
+		"TxDelete":              kitex.NewMethodInfo(txDeleteHandler, newKanBanDatabaseTxDeleteArgs, newKanBanDatabaseTxDeleteResult, false),
+		"Commit":                kitex.NewMethodInfo(commitHandler, newKanBanDatabaseCommitArgs, newKanBanDatabaseCommitResult, false),
+		"Rollback":              kitex.NewMethodInfo(rollbackHandler, newKanBanDatabaseRollbackArgs, newKanBanDatabaseRollbackResult, false),
+		"GetAccountData":        kitex.NewMethodInfo(getAccountDataHandler, newKanBanDatabaseGetAccountDataArgs, newKanBanDatabaseGetAccountDataResult, false),
+		"GetAccountDataV2":      kitex.NewMethodInfo(getAccountDataV2Handler, newKanBanDatabaseGetAccountDataV2Args, newKanBanDatabaseGetAccountDataV2Result, false),
+		"GetCode":               kitex.NewMethodInfo(getCodeHandler, newKanBanDatabaseGetCodeArgs, newKanBanDatabaseGetCodeResult, false),
+		"SetAccountData":        kitex.NewMethodInfo(setAccountDataHandler, newKanBanDatabaseSetAccountDataArgs, newKanBanDatabaseSetAccountDataResult, false),
+		"SubmitTransaction":     kitex.NewMethodInfo(submitTransactionHandler, newKanBanDatabaseSubmitTransactionArgs, newKanBanDatabaseSubmitTransactionResult, false),
+		"SubmitTransactions":    kitex.NewMethodInfo(submitTransactionsHandler, newKanBanDatabaseSubmitTransactionsArgs, newKanBanDatabaseSubmitTransactionsResult, false),
+		"GetTransactionByHash":  kitex.NewMethodInfo(getTransactionByHashHandler, newKanBanDatabaseGetTransactionByHashArgs, newKanBanDatabaseGetTransactionByHashResult, false),
+		"GetTransactionReceipt": kitex.NewMethodInfo(getTransactionReceiptHandler, newKanBanDatabaseGetTransactionReceiptArgs, newKanBanDatabaseGetTransactionReceiptResult, false),
+		"GetBlockByNumber":      kitex.NewMethodInfo(getBlockByNumberHandler, newKanBanDatabaseGetBlockByNumberArgs, newKanBanDatabaseGetBlockByNumberResult, false),
+		"GetBlockByHash":        kitex.NewMethodInfo(getBlockByHashHandler, newKanBanDatabaseGetBlockByHashArgs, newKanBanDatabaseGetBlockByHashResult, false),
+		"GetLatestBlock":        kitex.NewMethodInfo(getLatestBlockHandler, newKanBanDatabaseGetLatestBlockArgs, newKanBanDatabaseGetLatestBlockResult, false),
+		"TxPoolStatus":          kitex.NewMethodInfo(txPoolStatusHandler, newKanBanDatabaseTxPoolStatusArgs, newKanBanDatabaseTxPoolStatusResult, false),
+		"TxPoolContent":         kitex.NewMethodInfo(txPoolContentHandler, newKanBanDatabaseTxPoolContentArgs, newKanBanDatabaseTxPoolContentResult, false),
+		"TxPoolInspect":         kitex.NewMethodInfo(txPoolInspectHandler, newKanBanDatabaseTxPoolInspectArgs, newKanBanDatabaseTxPoolInspectResult, false),
+		"Call":                  kitex.NewMethodInfo(callHandler, newKanBanDatabaseCallArgs, newKanBanDatabaseCallResult, false),
+		"EstimateGas":           kitex.NewMethodInfo(estimateGasHandler, newKanBanDatabaseEstimateGasArgs, newKanBanDatabaseEstimateGasResult, false),
+		"GetStorageAt":          kitex.NewMethodInfo(getStorageAtHandler, newKanBanDatabaseGetStorageAtArgs, newKanBanDatabaseGetStorageAtResult, false),
+		"GetAccountStorage":     kitex.NewMethodInfo(getAccountStorageHandler, newKanBanDatabaseGetAccountStorageArgs, newKanBanDatabaseGetAccountStorageResult, false),
+		"GetProof":              kitex.NewMethodInfo(getProofHandler, newKanBanDatabaseGetProofArgs, newKanBanDatabaseGetProofResult, false),
 	}
 	extra := map[string]interface{}{
 		"PackageName": "api",
@@ -74,6 +115,438 @@ func newKanBanDatabasePutDataResult() interface{} {
 	return api.NewKanBanDatabasePutDataResult()
 }
 
+func compareAndSwapHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseCompareAndSwapArgs)
+	realResult := result.(*api.KanBanDatabaseCompareAndSwapResult)
+	success, err := handler.(api.KanBanDatabase).CompareAndSwap(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseCompareAndSwapArgs() interface{} {
+	return api.NewKanBanDatabaseCompareAndSwapArgs()
+}
+
+func newKanBanDatabaseCompareAndSwapResult() interface{} {
+	return api.NewKanBanDatabaseCompareAndSwapResult()
+}
+
+func getDataAtHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseGetDataAtArgs)
+	realResult := result.(*api.KanBanDatabaseGetDataAtResult)
+	success, err := handler.(api.KanBanDatabase).GetDataAt(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseGetDataAtArgs() interface{} {
+	return api.NewKanBanDatabaseGetDataAtArgs()
+}
+
+func newKanBanDatabaseGetDataAtResult() interface{} {
+	return api.NewKanBanDatabaseGetDataAtResult()
+}
+
+func getDataHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseGetDataHistoryArgs)
+	realResult := result.(*api.KanBanDatabaseGetDataHistoryResult)
+	success, err := handler.(api.KanBanDatabase).GetDataHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseGetDataHistoryArgs() interface{} {
+	return api.NewKanBanDatabaseGetDataHistoryArgs()
+}
+
+func newKanBanDatabaseGetDataHistoryResult() interface{} {
+	return api.NewKanBanDatabaseGetDataHistoryResult()
+}
+
+func getDataWithProofHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseGetDataWithProofArgs)
+	realResult := result.(*api.KanBanDatabaseGetDataWithProofResult)
+	success, err := handler.(api.KanBanDatabase).GetDataWithProof(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseGetDataWithProofArgs() interface{} {
+	return api.NewKanBanDatabaseGetDataWithProofArgs()
+}
+
+func newKanBanDatabaseGetDataWithProofResult() interface{} {
+	return api.NewKanBanDatabaseGetDataWithProofResult()
+}
+
+func multiGetHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseMultiGetArgs)
+	realResult := result.(*api.KanBanDatabaseMultiGetResult)
+	success, err := handler.(api.KanBanDatabase).MultiGet(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseMultiGetArgs() interface{} {
+	return api.NewKanBanDatabaseMultiGetArgs()
+}
+
+func newKanBanDatabaseMultiGetResult() interface{} {
+	return api.NewKanBanDatabaseMultiGetResult()
+}
+
+func multiPutHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseMultiPutArgs)
+	realResult := result.(*api.KanBanDatabaseMultiPutResult)
+	success, err := handler.(api.KanBanDatabase).MultiPut(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseMultiPutArgs() interface{} {
+	return api.NewKanBanDatabaseMultiPutArgs()
+}
+
+func newKanBanDatabaseMultiPutResult() interface{} {
+	return api.NewKanBanDatabaseMultiPutResult()
+}
+
+func deleteDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseDeleteDataArgs)
+	realResult := result.(*api.KanBanDatabaseDeleteDataResult)
+	success, err := handler.(api.KanBanDatabase).DeleteData(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseDeleteDataArgs() interface{} {
+	return api.NewKanBanDatabaseDeleteDataArgs()
+}
+
+func newKanBanDatabaseDeleteDataResult() interface{} {
+	return api.NewKanBanDatabaseDeleteDataResult()
+}
+
+func scanHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseScanArgs)
+	realResult := result.(*api.KanBanDatabaseScanResult)
+	success, err := handler.(api.KanBanDatabase).Scan(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseScanArgs() interface{} {
+	return api.NewKanBanDatabaseScanArgs()
+}
+
+func newKanBanDatabaseScanResult() interface{} {
+	return api.NewKanBanDatabaseScanResult()
+}
+
+func createNamespaceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseCreateNamespaceArgs)
+	realResult := result.(*api.KanBanDatabaseCreateNamespaceResult)
+	success, err := handler.(api.KanBanDatabase).CreateNamespace(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseCreateNamespaceArgs() interface{} {
+	return api.NewKanBanDatabaseCreateNamespaceArgs()
+}
+
+func newKanBanDatabaseCreateNamespaceResult() interface{} {
+	return api.NewKanBanDatabaseCreateNamespaceResult()
+}
+
+func listNamespacesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseListNamespacesArgs)
+	realResult := result.(*api.KanBanDatabaseListNamespacesResult)
+	success, err := handler.(api.KanBanDatabase).ListNamespaces(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseListNamespacesArgs() interface{} {
+	return api.NewKanBanDatabaseListNamespacesArgs()
+}
+
+func newKanBanDatabaseListNamespacesResult() interface{} {
+	return api.NewKanBanDatabaseListNamespacesResult()
+}
+
+func dropNamespaceHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseDropNamespaceArgs)
+	realResult := result.(*api.KanBanDatabaseDropNamespaceResult)
+	success, err := handler.(api.KanBanDatabase).DropNamespace(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseDropNamespaceArgs() interface{} {
+	return api.NewKanBanDatabaseDropNamespaceArgs()
+}
+
+func newKanBanDatabaseDropNamespaceResult() interface{} {
+	return api.NewKanBanDatabaseDropNamespaceResult()
+}
+
+func createIndexHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseCreateIndexArgs)
+	realResult := result.(*api.KanBanDatabaseCreateIndexResult)
+	success, err := handler.(api.KanBanDatabase).CreateIndex(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseCreateIndexArgs() interface{} {
+	return api.NewKanBanDatabaseCreateIndexArgs()
+}
+
+func newKanBanDatabaseCreateIndexResult() interface{} {
+	return api.NewKanBanDatabaseCreateIndexResult()
+}
+
+func dropIndexHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseDropIndexArgs)
+	realResult := result.(*api.KanBanDatabaseDropIndexResult)
+	success, err := handler.(api.KanBanDatabase).DropIndex(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseDropIndexArgs() interface{} {
+	return api.NewKanBanDatabaseDropIndexArgs()
+}
+
+func newKanBanDatabaseDropIndexResult() interface{} {
+	return api.NewKanBanDatabaseDropIndexResult()
+}
+
+func listIndexesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseListIndexesArgs)
+	realResult := result.(*api.KanBanDatabaseListIndexesResult)
+	success, err := handler.(api.KanBanDatabase).ListIndexes(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseListIndexesArgs() interface{} {
+	return api.NewKanBanDatabaseListIndexesArgs()
+}
+
+func newKanBanDatabaseListIndexesResult() interface{} {
+	return api.NewKanBanDatabaseListIndexesResult()
+}
+
+func queryByIndexHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseQueryByIndexArgs)
+	realResult := result.(*api.KanBanDatabaseQueryByIndexResult)
+	success, err := handler.(api.KanBanDatabase).QueryByIndex(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseQueryByIndexArgs() interface{} {
+	return api.NewKanBanDatabaseQueryByIndexArgs()
+}
+
+func newKanBanDatabaseQueryByIndexResult() interface{} {
+	return api.NewKanBanDatabaseQueryByIndexResult()
+}
+
+func subscribeChangesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseSubscribeChangesArgs)
+	realResult := result.(*api.KanBanDatabaseSubscribeChangesResult)
+	success, err := handler.(api.KanBanDatabase).SubscribeChanges(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseSubscribeChangesArgs() interface{} {
+	return api.NewKanBanDatabaseSubscribeChangesArgs()
+}
+
+func newKanBanDatabaseSubscribeChangesResult() interface{} {
+	return api.NewKanBanDatabaseSubscribeChangesResult()
+}
+
+func putBlobHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabasePutBlobArgs)
+	realResult := result.(*api.KanBanDatabasePutBlobResult)
+	success, err := handler.(api.KanBanDatabase).PutBlob(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabasePutBlobArgs() interface{} {
+	return api.NewKanBanDatabasePutBlobArgs()
+}
+
+func newKanBanDatabasePutBlobResult() interface{} {
+	return api.NewKanBanDatabasePutBlobResult()
+}
+
+func getBlobHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseGetBlobArgs)
+	realResult := result.(*api.KanBanDatabaseGetBlobResult)
+	success, err := handler.(api.KanBanDatabase).GetBlob(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseGetBlobArgs() interface{} {
+	return api.NewKanBanDatabaseGetBlobArgs()
+}
+
+func newKanBanDatabaseGetBlobResult() interface{} {
+	return api.NewKanBanDatabaseGetBlobResult()
+}
+
+func beginTxHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseBeginTxArgs)
+	realResult := result.(*api.KanBanDatabaseBeginTxResult)
+	success, err := handler.(api.KanBanDatabase).BeginTx(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseBeginTxArgs() interface{} {
+	return api.NewKanBanDatabaseBeginTxArgs()
+}
+
+func newKanBanDatabaseBeginTxResult() interface{} {
+	return api.NewKanBanDatabaseBeginTxResult()
+}
+
+func txGetHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseTxGetArgs)
+	realResult := result.(*api.KanBanDatabaseTxGetResult)
+	success, err := handler.(api.KanBanDatabase).TxGet(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseTxGetArgs() interface{} {
+	return api.NewKanBanDatabaseTxGetArgs()
+}
+
+func newKanBanDatabaseTxGetResult() interface{} {
+	return api.NewKanBanDatabaseTxGetResult()
+}
+
+func txPutHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseTxPutArgs)
+	realResult := result.(*api.KanBanDatabaseTxPutResult)
+	success, err := handler.(api.KanBanDatabase).TxPut(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseTxPutArgs() interface{} {
+	return api.NewKanBanDatabaseTxPutArgs()
+}
+
+func newKanBanDatabaseTxPutResult() interface{} {
+	return api.NewKanBanDatabaseTxPutResult()
+}
+
+func txDeleteHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseTxDeleteArgs)
+	realResult := result.(*api.KanBanDatabaseTxDeleteResult)
+	success, err := handler.(api.KanBanDatabase).TxDelete(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseTxDeleteArgs() interface{} {
+	return api.NewKanBanDatabaseTxDeleteArgs()
+}
+
+func newKanBanDatabaseTxDeleteResult() interface{} {
+	return api.NewKanBanDatabaseTxDeleteResult()
+}
+
+func commitHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseCommitArgs)
+	realResult := result.(*api.KanBanDatabaseCommitResult)
+	success, err := handler.(api.KanBanDatabase).Commit(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseCommitArgs() interface{} {
+	return api.NewKanBanDatabaseCommitArgs()
+}
+
+func newKanBanDatabaseCommitResult() interface{} {
+	return api.NewKanBanDatabaseCommitResult()
+}
+
+func rollbackHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseRollbackArgs)
+	realResult := result.(*api.KanBanDatabaseRollbackResult)
+	success, err := handler.(api.KanBanDatabase).Rollback(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseRollbackArgs() interface{} {
+	return api.NewKanBanDatabaseRollbackArgs()
+}
+
+func newKanBanDatabaseRollbackResult() interface{} {
+	return api.NewKanBanDatabaseRollbackResult()
+}
+
 func getAccountDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
 	realArg := arg.(*api.KanBanDatabaseGetAccountDataArgs)
 	realResult := result.(*api.KanBanDatabaseGetAccountDataResult)
@@ -88,26 +561,332 @@ func newKanBanDatabaseGetAccountDataArgs() interface{} {
 	return api.NewKanBanDatabaseGetAccountDataArgs()
 }
 
-func newKanBanDatabaseGetAccountDataResult() interface{} {
-	return api.NewKanBanDatabaseGetAccountDataResult()
+func newKanBanDatabaseGetAccountDataResult() interface{} {
+	return api.NewKanBanDatabaseGetAccountDataResult()
+}
+
+func getAccountDataV2Handler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseGetAccountDataV2Args)
+	realResult := result.(*api.KanBanDatabaseGetAccountDataV2Result)
+	success, err := handler.(api.KanBanDatabase).GetAccountDataV2(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseGetAccountDataV2Args() interface{} {
+	return api.NewKanBanDatabaseGetAccountDataV2Args()
+}
+
+func newKanBanDatabaseGetAccountDataV2Result() interface{} {
+	return api.NewKanBanDatabaseGetAccountDataV2Result()
+}
+
+func getCodeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseGetCodeArgs)
+	realResult := result.(*api.KanBanDatabaseGetCodeResult)
+	success, err := handler.(api.KanBanDatabase).GetCode(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseGetCodeArgs() interface{} {
+	return api.NewKanBanDatabaseGetCodeArgs()
+}
+
+func newKanBanDatabaseGetCodeResult() interface{} {
+	return api.NewKanBanDatabaseGetCodeResult()
+}
+
+func setAccountDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseSetAccountDataArgs)
+	realResult := result.(*api.KanBanDatabaseSetAccountDataResult)
+	success, err := handler.(api.KanBanDatabase).SetAccountData(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseSetAccountDataArgs() interface{} {
+	return api.NewKanBanDatabaseSetAccountDataArgs()
+}
+
+func newKanBanDatabaseSetAccountDataResult() interface{} {
+	return api.NewKanBanDatabaseSetAccountDataResult()
+}
+
+func submitTransactionHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseSubmitTransactionArgs)
+	realResult := result.(*api.KanBanDatabaseSubmitTransactionResult)
+	success, err := handler.(api.KanBanDatabase).SubmitTransaction(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseSubmitTransactionArgs() interface{} {
+	return api.NewKanBanDatabaseSubmitTransactionArgs()
+}
+
+func newKanBanDatabaseSubmitTransactionResult() interface{} {
+	return api.NewKanBanDatabaseSubmitTransactionResult()
+}
+
+func submitTransactionsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseSubmitTransactionsArgs)
+	realResult := result.(*api.KanBanDatabaseSubmitTransactionsResult)
+	success, err := handler.(api.KanBanDatabase).SubmitTransactions(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseSubmitTransactionsArgs() interface{} {
+	return api.NewKanBanDatabaseSubmitTransactionsArgs()
+}
+
+func newKanBanDatabaseSubmitTransactionsResult() interface{} {
+	return api.NewKanBanDatabaseSubmitTransactionsResult()
+}
+
+func getTransactionByHashHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseGetTransactionByHashArgs)
+	realResult := result.(*api.KanBanDatabaseGetTransactionByHashResult)
+	success, err := handler.(api.KanBanDatabase).GetTransactionByHash(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseGetTransactionByHashArgs() interface{} {
+	return api.NewKanBanDatabaseGetTransactionByHashArgs()
+}
+
+func newKanBanDatabaseGetTransactionByHashResult() interface{} {
+	return api.NewKanBanDatabaseGetTransactionByHashResult()
+}
+
+func getTransactionReceiptHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseGetTransactionReceiptArgs)
+	realResult := result.(*api.KanBanDatabaseGetTransactionReceiptResult)
+	success, err := handler.(api.KanBanDatabase).GetTransactionReceipt(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseGetTransactionReceiptArgs() interface{} {
+	return api.NewKanBanDatabaseGetTransactionReceiptArgs()
+}
+
+func newKanBanDatabaseGetTransactionReceiptResult() interface{} {
+	return api.NewKanBanDatabaseGetTransactionReceiptResult()
+}
+
+func getBlockByNumberHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseGetBlockByNumberArgs)
+	realResult := result.(*api.KanBanDatabaseGetBlockByNumberResult)
+	success, err := handler.(api.KanBanDatabase).GetBlockByNumber(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseGetBlockByNumberArgs() interface{} {
+	return api.NewKanBanDatabaseGetBlockByNumberArgs()
+}
+
+func newKanBanDatabaseGetBlockByNumberResult() interface{} {
+	return api.NewKanBanDatabaseGetBlockByNumberResult()
+}
+
+func getBlockByHashHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseGetBlockByHashArgs)
+	realResult := result.(*api.KanBanDatabaseGetBlockByHashResult)
+	success, err := handler.(api.KanBanDatabase).GetBlockByHash(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseGetBlockByHashArgs() interface{} {
+	return api.NewKanBanDatabaseGetBlockByHashArgs()
+}
+
+func newKanBanDatabaseGetBlockByHashResult() interface{} {
+	return api.NewKanBanDatabaseGetBlockByHashResult()
+}
+
+func getLatestBlockHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseGetLatestBlockArgs)
+	realResult := result.(*api.KanBanDatabaseGetLatestBlockResult)
+	success, err := handler.(api.KanBanDatabase).GetLatestBlock(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseGetLatestBlockArgs() interface{} {
+	return api.NewKanBanDatabaseGetLatestBlockArgs()
+}
+
+func newKanBanDatabaseGetLatestBlockResult() interface{} {
+	return api.NewKanBanDatabaseGetLatestBlockResult()
+}
+
+func txPoolStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseTxPoolStatusArgs)
+	realResult := result.(*api.KanBanDatabaseTxPoolStatusResult)
+	success, err := handler.(api.KanBanDatabase).TxPoolStatus(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseTxPoolStatusArgs() interface{} {
+	return api.NewKanBanDatabaseTxPoolStatusArgs()
+}
+
+func newKanBanDatabaseTxPoolStatusResult() interface{} {
+	return api.NewKanBanDatabaseTxPoolStatusResult()
+}
+
+func txPoolContentHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseTxPoolContentArgs)
+	realResult := result.(*api.KanBanDatabaseTxPoolContentResult)
+	success, err := handler.(api.KanBanDatabase).TxPoolContent(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseTxPoolContentArgs() interface{} {
+	return api.NewKanBanDatabaseTxPoolContentArgs()
+}
+
+func newKanBanDatabaseTxPoolContentResult() interface{} {
+	return api.NewKanBanDatabaseTxPoolContentResult()
+}
+
+func txPoolInspectHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseTxPoolInspectArgs)
+	realResult := result.(*api.KanBanDatabaseTxPoolInspectResult)
+	success, err := handler.(api.KanBanDatabase).TxPoolInspect(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseTxPoolInspectArgs() interface{} {
+	return api.NewKanBanDatabaseTxPoolInspectArgs()
+}
+
+func newKanBanDatabaseTxPoolInspectResult() interface{} {
+	return api.NewKanBanDatabaseTxPoolInspectResult()
+}
+
+func callHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseCallArgs)
+	realResult := result.(*api.KanBanDatabaseCallResult)
+	success, err := handler.(api.KanBanDatabase).Call(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseCallArgs() interface{} {
+	return api.NewKanBanDatabaseCallArgs()
+}
+
+func newKanBanDatabaseCallResult() interface{} {
+	return api.NewKanBanDatabaseCallResult()
+}
+
+func estimateGasHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseEstimateGasArgs)
+	realResult := result.(*api.KanBanDatabaseEstimateGasResult)
+	success, err := handler.(api.KanBanDatabase).EstimateGas(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseEstimateGasArgs() interface{} {
+	return api.NewKanBanDatabaseEstimateGasArgs()
+}
+
+func newKanBanDatabaseEstimateGasResult() interface{} {
+	return api.NewKanBanDatabaseEstimateGasResult()
+}
+
+func getStorageAtHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseGetStorageAtArgs)
+	realResult := result.(*api.KanBanDatabaseGetStorageAtResult)
+	success, err := handler.(api.KanBanDatabase).GetStorageAt(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseGetStorageAtArgs() interface{} {
+	return api.NewKanBanDatabaseGetStorageAtArgs()
+}
+
+func newKanBanDatabaseGetStorageAtResult() interface{} {
+	return api.NewKanBanDatabaseGetStorageAtResult()
+}
+
+func getAccountStorageHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseGetAccountStorageArgs)
+	realResult := result.(*api.KanBanDatabaseGetAccountStorageResult)
+	success, err := handler.(api.KanBanDatabase).GetAccountStorage(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newKanBanDatabaseGetAccountStorageArgs() interface{} {
+	return api.NewKanBanDatabaseGetAccountStorageArgs()
+}
+
+func newKanBanDatabaseGetAccountStorageResult() interface{} {
+	return api.NewKanBanDatabaseGetAccountStorageResult()
 }
 
-func setAccountDataHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
-	realArg := arg.(*api.KanBanDatabaseSetAccountDataArgs)
-	realResult := result.(*api.KanBanDatabaseSetAccountDataResult)
-	success, err := handler.(api.KanBanDatabase).SetAccountData(ctx, realArg.Req)
+func getProofHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*api.KanBanDatabaseGetProofArgs)
+	realResult := result.(*api.KanBanDatabaseGetProofResult)
+	success, err := handler.(api.KanBanDatabase).GetProof(ctx, realArg.Req)
 	if err != nil {
 		return err
 	}
 	realResult.Success = success
 	return nil
 }
-func newKanBanDatabaseSetAccountDataArgs() interface{} {
-	return api.NewKanBanDatabaseSetAccountDataArgs()
+func newKanBanDatabaseGetProofArgs() interface{} {
+	return api.NewKanBanDatabaseGetProofArgs()
 }
 
-func newKanBanDatabaseSetAccountDataResult() interface{} {
-	return api.NewKanBanDatabaseSetAccountDataResult()
+func newKanBanDatabaseGetProofResult() interface{} {
+	return api.NewKanBanDatabaseGetProofResult()
 }
 
 type kClient struct {
@@ -140,6 +919,246 @@ func (p *kClient) PutData(ctx context.Context, req *api.PutDataRequest) (r *api.
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) CompareAndSwap(ctx context.Context, req *api.CompareAndSwapRequest) (r *api.CompareAndSwapResponse, err error) {
+	var _args api.KanBanDatabaseCompareAndSwapArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseCompareAndSwapResult
+	if err = p.c.Call(ctx, "CompareAndSwap", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetDataAt(ctx context.Context, req *api.GetDataAtRequest) (r *api.GetDataAtResponse, err error) {
+	var _args api.KanBanDatabaseGetDataAtArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseGetDataAtResult
+	if err = p.c.Call(ctx, "GetDataAt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetDataHistory(ctx context.Context, req *api.GetDataHistoryRequest) (r *api.GetDataHistoryResponse, err error) {
+	var _args api.KanBanDatabaseGetDataHistoryArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseGetDataHistoryResult
+	if err = p.c.Call(ctx, "GetDataHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetDataWithProof(ctx context.Context, req *api.GetDataWithProofRequest) (r *api.GetDataWithProofResponse, err error) {
+	var _args api.KanBanDatabaseGetDataWithProofArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseGetDataWithProofResult
+	if err = p.c.Call(ctx, "GetDataWithProof", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MultiGet(ctx context.Context, req *api.MultiGetRequest) (r *api.MultiGetResponse, err error) {
+	var _args api.KanBanDatabaseMultiGetArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseMultiGetResult
+	if err = p.c.Call(ctx, "MultiGet", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MultiPut(ctx context.Context, req *api.MultiPutRequest) (r *api.MultiPutResponse, err error) {
+	var _args api.KanBanDatabaseMultiPutArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseMultiPutResult
+	if err = p.c.Call(ctx, "MultiPut", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteData(ctx context.Context, req *api.DeleteDataRequest) (r *api.DeleteDataResponse, err error) {
+	var _args api.KanBanDatabaseDeleteDataArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseDeleteDataResult
+	if err = p.c.Call(ctx, "DeleteData", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Scan(ctx context.Context, req *api.ScanRequest) (r *api.ScanResponse, err error) {
+	var _args api.KanBanDatabaseScanArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseScanResult
+	if err = p.c.Call(ctx, "Scan", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateNamespace(ctx context.Context, req *api.CreateNamespaceRequest) (r *api.CreateNamespaceResponse, err error) {
+	var _args api.KanBanDatabaseCreateNamespaceArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseCreateNamespaceResult
+	if err = p.c.Call(ctx, "CreateNamespace", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListNamespaces(ctx context.Context, req *api.ListNamespacesRequest) (r *api.ListNamespacesResponse, err error) {
+	var _args api.KanBanDatabaseListNamespacesArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseListNamespacesResult
+	if err = p.c.Call(ctx, "ListNamespaces", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DropNamespace(ctx context.Context, req *api.DropNamespaceRequest) (r *api.DropNamespaceResponse, err error) {
+	var _args api.KanBanDatabaseDropNamespaceArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseDropNamespaceResult
+	if err = p.c.Call(ctx, "DropNamespace", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateIndex(ctx context.Context, req *api.CreateIndexRequest) (r *api.CreateIndexResponse, err error) {
+	var _args api.KanBanDatabaseCreateIndexArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseCreateIndexResult
+	if err = p.c.Call(ctx, "CreateIndex", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DropIndex(ctx context.Context, req *api.DropIndexRequest) (r *api.DropIndexResponse, err error) {
+	var _args api.KanBanDatabaseDropIndexArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseDropIndexResult
+	if err = p.c.Call(ctx, "DropIndex", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListIndexes(ctx context.Context, req *api.ListIndexesRequest) (r *api.ListIndexesResponse, err error) {
+	var _args api.KanBanDatabaseListIndexesArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseListIndexesResult
+	if err = p.c.Call(ctx, "ListIndexes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) QueryByIndex(ctx context.Context, req *api.QueryByIndexRequest) (r *api.QueryByIndexResponse, err error) {
+	var _args api.KanBanDatabaseQueryByIndexArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseQueryByIndexResult
+	if err = p.c.Call(ctx, "QueryByIndex", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SubscribeChanges(ctx context.Context, req *api.SubscribeChangesRequest) (r *api.SubscribeChangesResponse, err error) {
+	var _args api.KanBanDatabaseSubscribeChangesArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseSubscribeChangesResult
+	if err = p.c.Call(ctx, "SubscribeChanges", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PutBlob(ctx context.Context, req *api.PutBlobRequest) (r *api.PutBlobResponse, err error) {
+	var _args api.KanBanDatabasePutBlobArgs
+	_args.Req = req
+	var _result api.KanBanDatabasePutBlobResult
+	if err = p.c.Call(ctx, "PutBlob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetBlob(ctx context.Context, req *api.GetBlobRequest) (r *api.GetBlobResponse, err error) {
+	var _args api.KanBanDatabaseGetBlobArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseGetBlobResult
+	if err = p.c.Call(ctx, "GetBlob", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) BeginTx(ctx context.Context, req *api.BeginTxRequest) (r *api.BeginTxResponse, err error) {
+	var _args api.KanBanDatabaseBeginTxArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseBeginTxResult
+	if err = p.c.Call(ctx, "BeginTx", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TxGet(ctx context.Context, req *api.TxGetRequest) (r *api.TxGetResponse, err error) {
+	var _args api.KanBanDatabaseTxGetArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseTxGetResult
+	if err = p.c.Call(ctx, "TxGet", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TxPut(ctx context.Context, req *api.TxPutRequest) (r *api.TxPutResponse, err error) {
+	var _args api.KanBanDatabaseTxPutArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseTxPutResult
+	if err = p.c.Call(ctx, "TxPut", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TxDelete(ctx context.Context, req *api.TxDeleteRequest) (r *api.TxDeleteResponse, err error) {
+	var _args api.KanBanDatabaseTxDeleteArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseTxDeleteResult
+	if err = p.c.Call(ctx, "TxDelete", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Commit(ctx context.Context, req *api.CommitRequest) (r *api.CommitResponse, err error) {
+	var _args api.KanBanDatabaseCommitArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseCommitResult
+	if err = p.c.Call(ctx, "Commit", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Rollback(ctx context.Context, req *api.RollbackRequest) (r *api.RollbackResponse, err error) {
+	var _args api.KanBanDatabaseRollbackArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseRollbackResult
+	if err = p.c.Call(ctx, "Rollback", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) GetAccountData(ctx context.Context, req *api.GetAccountDataRequest) (r *api.GetAccountDataResponse, err error) {
 	var _args api.KanBanDatabaseGetAccountDataArgs
 	_args.Req = req
@@ -150,6 +1169,26 @@ func (p *kClient) GetAccountData(ctx context.Context, req *api.GetAccountDataReq
 	return _result.GetSuccess(), nil
 }
 
+func (p *kClient) GetAccountDataV2(ctx context.Context, req *api.GetAccountDataRequest) (r *api.GetAccountDataV2Response, err error) {
+	var _args api.KanBanDatabaseGetAccountDataV2Args
+	_args.Req = req
+	var _result api.KanBanDatabaseGetAccountDataV2Result
+	if err = p.c.Call(ctx, "GetAccountDataV2", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetCode(ctx context.Context, req *api.GetCodeRequest) (r *api.GetCodeResponse, err error) {
+	var _args api.KanBanDatabaseGetCodeArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseGetCodeResult
+	if err = p.c.Call(ctx, "GetCode", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
 func (p *kClient) SetAccountData(ctx context.Context, req *api.SetAccountDataRequest) (r *api.SetAccountDataResponse, err error) {
 	var _args api.KanBanDatabaseSetAccountDataArgs
 	_args.Req = req
@@ -159,3 +1198,153 @@ func (p *kClient) SetAccountData(ctx context.Context, req *api.SetAccountDataReq
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) SubmitTransaction(ctx context.Context, req *api.SubmitTransactionRequest) (r *api.SubmitTransactionResponse, err error) {
+	var _args api.KanBanDatabaseSubmitTransactionArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseSubmitTransactionResult
+	if err = p.c.Call(ctx, "SubmitTransaction", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SubmitTransactions(ctx context.Context, req *api.SubmitTransactionsRequest) (r *api.SubmitTransactionsResponse, err error) {
+	var _args api.KanBanDatabaseSubmitTransactionsArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseSubmitTransactionsResult
+	if err = p.c.Call(ctx, "SubmitTransactions", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetTransactionByHash(ctx context.Context, req *api.GetTransactionByHashRequest) (r *api.GetTransactionByHashResponse, err error) {
+	var _args api.KanBanDatabaseGetTransactionByHashArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseGetTransactionByHashResult
+	if err = p.c.Call(ctx, "GetTransactionByHash", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetTransactionReceipt(ctx context.Context, req *api.GetTransactionReceiptRequest) (r *api.GetTransactionReceiptResponse, err error) {
+	var _args api.KanBanDatabaseGetTransactionReceiptArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseGetTransactionReceiptResult
+	if err = p.c.Call(ctx, "GetTransactionReceipt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetBlockByNumber(ctx context.Context, req *api.GetBlockByNumberRequest) (r *api.GetBlockResponse, err error) {
+	var _args api.KanBanDatabaseGetBlockByNumberArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseGetBlockByNumberResult
+	if err = p.c.Call(ctx, "GetBlockByNumber", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetBlockByHash(ctx context.Context, req *api.GetBlockByHashRequest) (r *api.GetBlockResponse, err error) {
+	var _args api.KanBanDatabaseGetBlockByHashArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseGetBlockByHashResult
+	if err = p.c.Call(ctx, "GetBlockByHash", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetLatestBlock(ctx context.Context, req *api.GetLatestBlockRequest) (r *api.GetBlockResponse, err error) {
+	var _args api.KanBanDatabaseGetLatestBlockArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseGetLatestBlockResult
+	if err = p.c.Call(ctx, "GetLatestBlock", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TxPoolStatus(ctx context.Context, req *api.TxPoolStatusRequest) (r *api.TxPoolStatusResponse, err error) {
+	var _args api.KanBanDatabaseTxPoolStatusArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseTxPoolStatusResult
+	if err = p.c.Call(ctx, "TxPoolStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TxPoolContent(ctx context.Context, req *api.TxPoolContentRequest) (r *api.TxPoolContentResponse, err error) {
+	var _args api.KanBanDatabaseTxPoolContentArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseTxPoolContentResult
+	if err = p.c.Call(ctx, "TxPoolContent", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TxPoolInspect(ctx context.Context, req *api.TxPoolInspectRequest) (r *api.TxPoolInspectResponse, err error) {
+	var _args api.KanBanDatabaseTxPoolInspectArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseTxPoolInspectResult
+	if err = p.c.Call(ctx, "TxPoolInspect", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) Call(ctx context.Context, req *api.CallRequest) (r *api.CallResponse, err error) {
+	var _args api.KanBanDatabaseCallArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseCallResult
+	if err = p.c.Call(ctx, "Call", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) EstimateGas(ctx context.Context, req *api.CallRequest) (r *api.EstimateGasResponse, err error) {
+	var _args api.KanBanDatabaseEstimateGasArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseEstimateGasResult
+	if err = p.c.Call(ctx, "EstimateGas", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetStorageAt(ctx context.Context, req *api.GetStorageAtRequest) (r *api.GetStorageAtResponse, err error) {
+	var _args api.KanBanDatabaseGetStorageAtArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseGetStorageAtResult
+	if err = p.c.Call(ctx, "GetStorageAt", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetAccountStorage(ctx context.Context, req *api.GetAccountStorageRequest) (r *api.GetAccountStorageResponse, err error) {
+	var _args api.KanBanDatabaseGetAccountStorageArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseGetAccountStorageResult
+	if err = p.c.Call(ctx, "GetAccountStorage", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetProof(ctx context.Context, req *api.GetProofRequest) (r *api.GetProofResponse, err error) {
+	var _args api.KanBanDatabaseGetProofArgs
+	_args.Req = req
+	var _result api.KanBanDatabaseGetProofResult
+	if err = p.c.Call(ctx, "GetProof", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
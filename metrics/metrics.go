@@ -47,6 +47,15 @@ func NewTimer(name string) metrics.Timer {
 	return metrics.GetOrRegisterTimer(name, metrics.DefaultRegistry)
 }
 
+// NewGauge create a new metrics Gauge, either a real one of a NOP stub depending
+// on the metrics flag.
+func NewGauge(name string) metrics.Gauge {
+	if !enabled {
+		return new(metrics.NilGauge)
+	}
+	return metrics.GetOrRegisterGauge(name, metrics.DefaultRegistry)
+}
+
 // CollectProcessMetrics periodically collects various metrics about the running
 // process.
 func CollectProcessMetrics(refresh time.Duration) {
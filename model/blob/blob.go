@@ -0,0 +1,258 @@
+package blob
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/crypto"
+	"github.com/MonteCarloClub/KBD/rlp"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+var (
+	// ChunkSize is the size blobs are split into. Only the last chunk of a
+	// blob may be smaller.
+	ChunkSize = 256 << 10
+	// UploadTimeout is how long an upload may go without data. Expired
+	// uploads are dropped the next time one is started.
+	UploadTimeout = 10 * time.Minute
+)
+
+var (
+	ErrBlobNotFound   = errors.New("blob not found")
+	ErrUploadNotFound = errors.New("upload not found")
+	ErrInvalidRange   = errors.New("invalid blob range")
+)
+
+var (
+	// chunkPrefix and manifestPrefix prefix the keys chunks and manifests
+	// are stored under, followed by the Sha3 hash of their content.
+	chunkPrefix    = []byte("c")
+	manifestPrefix = []byte("m")
+)
+
+// Store is the key/value store blobs are kept in. Chunks are written in
+// batches of their own, so they never wait in a write queue.
+type Store interface {
+	Get(key []byte) ([]byte, error)
+	WriteBatch(keys, values, deleted [][]byte) error
+}
+
+// manifest lists the chunks of a blob. Its hash is the root of the blob.
+type manifest struct {
+	Size      uint64
+	ChunkSize uint64
+	Chunks    [][]byte
+}
+
+func prefixed(prefix []byte, hash []byte) []byte {
+	return append(append([]byte{}, prefix...), hash...)
+}
+
+// Writer splits what is written to it into chunks and stores them, skipping
+// chunks that are stored already.
+type Writer struct {
+	store  Store
+	buf    []byte
+	chunks [][]byte
+	size   uint64
+}
+
+func NewWriter(store Store) *Writer {
+	return &Writer{store: store}
+}
+
+// Write stores the full chunks of what has been written so far. Nothing of p
+// is taken if it fails, so that it can be written again.
+func (self *Writer) Write(p []byte) (int, error) {
+	buf, chunks := self.buf, len(self.chunks)
+	self.buf = append(self.buf, p...)
+	for len(self.buf) >= ChunkSize {
+		if err := self.writeChunk(self.buf[:ChunkSize]); err != nil {
+			self.buf, self.chunks = buf, self.chunks[:chunks]
+			return 0, err
+		}
+		self.buf = append([]byte{}, self.buf[ChunkSize:]...)
+	}
+	self.size += uint64(len(p))
+	return len(p), nil
+}
+
+func (self *Writer) writeChunk(chunk []byte) error {
+	hash := crypto.Sha3(chunk)
+	key := prefixed(chunkPrefix, hash)
+	if _, err := self.store.Get(key); err == leveldb.ErrNotFound {
+		if err := self.store.WriteBatch([][]byte{key}, [][]byte{common.CopyBytes(chunk)}, nil); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+	self.chunks = append(self.chunks, hash)
+	return nil
+}
+
+// Commit stores the last chunk and the manifest and returns the root of the
+// blob and its size.
+func (self *Writer) Commit() (common.Hash, uint64, error) {
+	if len(self.buf) > 0 {
+		if err := self.writeChunk(self.buf); err != nil {
+			return common.Hash{}, 0, err
+		}
+		self.buf = nil
+	}
+	data, err := rlp.EncodeToBytes(manifest{Size: self.size, ChunkSize: uint64(ChunkSize), Chunks: self.chunks})
+	if err != nil {
+		return common.Hash{}, 0, err
+	}
+	root := crypto.Sha3(data)
+	if err := self.store.WriteBatch([][]byte{prefixed(manifestPrefix, root)}, [][]byte{data}, nil); err != nil {
+		return common.Hash{}, 0, err
+	}
+	return common.BytesToHash(root), self.size, nil
+}
+
+type upload struct {
+	mu     sync.Mutex
+	writer *Writer
+	last   time.Time
+}
+
+// Blobs keeps blobs in a Store and tracks uploads made over several calls.
+type Blobs struct {
+	store Store
+
+	mu      sync.Mutex
+	uploads map[string]*upload
+}
+
+func New(store Store) *Blobs {
+	return &Blobs{store: store, uploads: make(map[string]*upload)}
+}
+
+// Begin starts an upload and returns its id.
+func (self *Blobs) Begin() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	now := time.Now()
+	for id, u := range self.uploads {
+		u.mu.Lock()
+		if now.Sub(u.last) > UploadTimeout {
+			delete(self.uploads, id)
+		}
+		u.mu.Unlock()
+	}
+	self.uploads[hex.EncodeToString(id)] = &upload{writer: NewWriter(self.store), last: now}
+	return hex.EncodeToString(id), nil
+}
+
+func (self *Blobs) upload(id string) *upload {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	return self.uploads[id]
+}
+
+// Append adds data to the end of an upload.
+func (self *Blobs) Append(id string, data []byte) error {
+	u := self.upload(id)
+	if u == nil {
+		return ErrUploadNotFound
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.last = time.Now()
+	_, err := u.writer.Write(data)
+	return err
+}
+
+// Finish completes an upload and returns the root and size of the blob.
+func (self *Blobs) Finish(id string) (common.Hash, uint64, error) {
+	self.mu.Lock()
+	u := self.uploads[id]
+	delete(self.uploads, id)
+	self.mu.Unlock()
+	if u == nil {
+		return common.Hash{}, 0, ErrUploadNotFound
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.writer.Commit()
+}
+
+func (self *Blobs) manifest(root common.Hash) (*manifest, error) {
+	data, err := self.store.Get(prefixed(manifestPrefix, root[:]))
+	if err == leveldb.ErrNotFound {
+		return nil, ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	m := new(manifest)
+	if err := rlp.DecodeBytes(data, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Size returns the size of a blob.
+func (self *Blobs) Size(root common.Hash) (uint64, error) {
+	m, err := self.manifest(root)
+	if err != nil {
+		return 0, err
+	}
+	return m.Size, nil
+}
+
+// ReadAt returns up to length bytes of a blob from offset on, reading only
+// the chunks they are in, together with the size of the blob.
+func (self *Blobs) ReadAt(root common.Hash, offset, length uint64) ([]byte, uint64, error) {
+	m, err := self.manifest(root)
+	if err != nil {
+		return nil, 0, err
+	}
+	if offset > m.Size {
+		return nil, m.Size, ErrInvalidRange
+	}
+	if length > m.Size-offset {
+		length = m.Size - offset
+	}
+
+	data := make([]byte, 0, length)
+	for pos := offset; pos < offset+length; {
+		i := pos / m.ChunkSize
+		if i >= uint64(len(m.Chunks)) {
+			return nil, m.Size, ErrBlobNotFound
+		}
+		chunk, err := self.store.Get(prefixed(chunkPrefix, m.Chunks[i]))
+		if err == leveldb.ErrNotFound {
+			return nil, m.Size, ErrBlobNotFound
+		}
+		if err != nil {
+			return nil, m.Size, err
+		}
+		start := pos - i*m.ChunkSize
+		end := uint64(len(chunk))
+		if rest := offset + length - i*m.ChunkSize; rest < end {
+			end = rest
+		}
+		if start >= end {
+			return nil, m.Size, ErrBlobNotFound
+		}
+		data = append(data, chunk[start:end]...)
+		pos += end - start
+	}
+	return data, m.Size, nil
+}
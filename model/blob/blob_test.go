@@ -0,0 +1,104 @@
+package blob
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/MonteCarloClub/KBD/model/kdb"
+)
+
+func TestBlob(t *testing.T) {
+	ChunkSize = 4
+	db, err := kdb.NewLDBDatabase(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	blobs := New(db)
+
+	content := []byte("abcdabcdabcdxyz")
+	id, _ := blobs.Begin()
+	for _, part := range [][]byte{content[:3], content[3:10], content[10:]} {
+		if err := blobs.Append(id, part); err != nil {
+			t.Fatal(err)
+		}
+	}
+	root, size, err := blobs.Finish(id)
+	if err != nil || size != uint64(len(content)) {
+		t.Fatalf("unexpected finish %x %d %v", root, size, err)
+	}
+	if err := blobs.Append(id, []byte("more")); err != ErrUploadNotFound {
+		t.Errorf("expected ErrUploadNotFound, got %v", err)
+	}
+
+	// Repeated chunks are stored once.
+	m, _ := blobs.manifest(root)
+	if len(m.Chunks) != 4 || !bytes.Equal(m.Chunks[0], m.Chunks[2]) {
+		t.Errorf("unexpected chunks %x", m.Chunks)
+	}
+
+	for _, r := range []struct{ offset, length uint64 }{{0, 100}, {2, 7}, {13, 1}, {15, 4}} {
+		data, _, err := blobs.ReadAt(root, r.offset, r.length)
+		end := r.offset + r.length
+		if end > uint64(len(content)) {
+			end = uint64(len(content))
+		}
+		if err != nil || !bytes.Equal(data, content[r.offset:end]) {
+			t.Errorf("read %d+%d: got %q %v", r.offset, r.length, data, err)
+		}
+	}
+	if _, _, err := blobs.ReadAt(root, 16, 1); err != ErrInvalidRange {
+		t.Errorf("expected ErrInvalidRange, got %v", err)
+	}
+
+	// The same content has the same root.
+	w := NewWriter(db)
+	w.Write(content)
+	if again, _, _ := w.Commit(); again != root {
+		t.Errorf("expected root %x, got %x", root, again)
+	}
+}
+
+// failingStore fails to write once it has written left times, unless left
+// is negative.
+type failingStore struct {
+	Store
+	left int
+}
+
+func (self *failingStore) WriteBatch(keys, values, deleted [][]byte) error {
+	if self.left == 0 {
+		return errors.New("write failed")
+	}
+	self.left--
+	return self.Store.WriteBatch(keys, values, deleted)
+}
+
+func TestWriteRetry(t *testing.T) {
+	ChunkSize = 4
+	db, err := kdb.NewLDBDatabase(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := &failingStore{Store: db, left: -1}
+	w := NewWriter(store)
+
+	// The first chunk is stored before the second fails.
+	w.Write([]byte("ab"))
+	store.left = 1
+	if _, err := w.Write([]byte("cdefghij")); err == nil {
+		t.Fatal("expected the write to fail")
+	}
+	store.left = -1
+	if _, err := w.Write([]byte("cdefghij")); err != nil {
+		t.Fatal(err)
+	}
+	root, size, err := w.Commit()
+	if err != nil || size != 10 {
+		t.Fatalf("unexpected commit %x %d %v", root, size, err)
+	}
+	data, _, err := New(db).ReadAt(root, 0, 100)
+	if err != nil || string(data) != "abcdefghij" {
+		t.Errorf("expected abcdefghij, got %q %v", data, err)
+	}
+}
@@ -0,0 +1,195 @@
+package changelog
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+type Kind string
+
+const (
+	KindKV      Kind = "kv"
+	KindAccount Kind = "account"
+)
+
+// Change is a committed mutation. KV changes carry the new value and
+// version of the key, account changes the address as Key and the RLP
+// encoded account as Value.
+type Change struct {
+	Seq       uint64
+	Time      time.Time
+	Kind      Kind
+	Namespace string `json:",omitempty"`
+	Key       []byte
+	Value     []byte `json:",omitempty"`
+	Deleted   bool   `json:",omitempty"`
+	Version   uint64 `json:",omitempty"`
+}
+
+// Store is the key/value store the log is kept in.
+type Store interface {
+	Get(key []byte) ([]byte, error)
+	WriteBatch(keys, values, deleted [][]byte) error
+	Scan(prefix, start, end []byte, limit int) (keys, values [][]byte, next []byte, err error)
+}
+
+var (
+	// changePrefix prefixes the keys changes are kept under, followed by
+	// their sequence number.
+	changePrefix = []byte("c")
+	// headKey holds the sequence number of the last change.
+	headKey = []byte("head")
+)
+
+func changeKey(seq uint64) []byte {
+	key := make([]byte, len(changePrefix)+8)
+	copy(key, changePrefix)
+	binary.BigEndian.PutUint64(key[len(changePrefix):], seq)
+	return key
+}
+
+// Log is an append-only, sequence numbered log of changes. Sequence numbers
+// start at one and have no gaps.
+type Log struct {
+	mu    sync.Mutex
+	store Store
+	head  uint64
+
+	// appended is closed and replaced whenever changes are appended.
+	appended chan struct{}
+}
+
+// New opens the log kept in store.
+func New(store Store) (*Log, error) {
+	self := &Log{store: store, appended: make(chan struct{})}
+	data, err := store.Get(headKey)
+	switch {
+	case err == leveldb.ErrNotFound:
+	case err != nil:
+		return nil, err
+	case len(data) == 8:
+		self.head = binary.BigEndian.Uint64(data)
+	}
+	return self, nil
+}
+
+// Head returns the sequence number of the last change, zero if there is
+// none.
+func (self *Log) Head() uint64 {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	return self.head
+}
+
+// Append numbers the changes and writes them to the log as a single batch.
+func (self *Log) Append(changes ...Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	p, err := self.Begin(changes)
+	if err != nil {
+		return err
+	}
+	if err := self.store.WriteBatch(p.Keys, p.Values, nil); err != nil {
+		p.Abort()
+		return err
+	}
+	p.Commit()
+	return nil
+}
+
+// Pending are changes numbered but not yet in the log. Keys and Values are
+// the records to write, in the same batch as the mutation they describe so
+// that the log never misses nor invents one. Until Commit or Abort is
+// called no other changes can be appended.
+type Pending struct {
+	log    *Log
+	n      uint64
+	Keys   [][]byte
+	Values [][]byte
+}
+
+// Begin numbers the changes and returns the records to write them with.
+func (self *Log) Begin(changes []Change) (*Pending, error) {
+	self.mu.Lock()
+
+	now := time.Now()
+	p := &Pending{log: self, n: uint64(len(changes))}
+	for i := range changes {
+		changes[i].Seq = self.head + uint64(i) + 1
+		if changes[i].Time.IsZero() {
+			changes[i].Time = now
+		}
+		data, err := json.Marshal(&changes[i])
+		if err != nil {
+			self.mu.Unlock()
+			return nil, err
+		}
+		p.Keys = append(p.Keys, changeKey(changes[i].Seq))
+		p.Values = append(p.Values, data)
+	}
+	head := make([]byte, 8)
+	binary.BigEndian.PutUint64(head, self.head+p.n)
+	p.Keys = append(p.Keys, headKey)
+	p.Values = append(p.Values, head)
+	return p, nil
+}
+
+// Commit marks the changes as written and wakes up waiting readers.
+func (self *Pending) Commit() {
+	self.log.head += self.n
+	close(self.log.appended)
+	self.log.appended = make(chan struct{})
+	self.log.mu.Unlock()
+}
+
+// Abort gives up the changes, their records were not written.
+func (self *Pending) Abort() {
+	self.log.mu.Unlock()
+}
+
+// Read returns up to limit changes starting at sequence number from.
+func (self *Log) Read(from uint64, limit int) ([]Change, error) {
+	if from == 0 {
+		from = 1
+	}
+	keys, values, _, err := self.store.Scan(changePrefix, changeKey(from), nil, limit)
+	if err != nil {
+		return nil, err
+	}
+	changes := make([]Change, len(keys))
+	for i := range keys {
+		if err := json.Unmarshal(values[i], &changes[i]); err != nil {
+			return nil, err
+		}
+	}
+	return changes, nil
+}
+
+// Wait is Read, but waits up to timeout for changes if there are none yet.
+func (self *Log) Wait(from uint64, limit int, timeout time.Duration) ([]Change, error) {
+	if from == 0 {
+		from = 1
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		self.mu.Lock()
+		head, appended := self.head, self.appended
+		self.mu.Unlock()
+		if head >= from {
+			return self.Read(from, limit)
+		}
+
+		select {
+		case <-appended:
+		case <-timer.C:
+			return nil, nil
+		}
+	}
+}
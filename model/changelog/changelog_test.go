@@ -0,0 +1,49 @@
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/MonteCarloClub/KBD/model/kdb"
+)
+
+func TestLog(t *testing.T) {
+	db, err := kdb.NewLDBDatabase(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	log, _ := New(db)
+	log.Append(Change{Kind: KindKV, Key: []byte("a"), Value: []byte("1"), Version: 1})
+	log.Append(Change{Kind: KindKV, Key: []byte("a"), Deleted: true, Version: 2},
+		Change{Kind: KindAccount, Key: []byte{1, 2}})
+	if log.Head() != 3 {
+		t.Errorf("expected head 3, got %d", log.Head())
+	}
+
+	// Aborted changes leave no gap.
+	p, _ := log.Begin([]Change{{Kind: KindKV, Key: []byte("x")}})
+	p.Abort()
+
+	changes, err := log.Read(2, 10)
+	if err != nil || len(changes) != 2 || changes[0].Seq != 2 || !changes[0].Deleted || changes[1].Kind != KindAccount {
+		t.Fatalf("unexpected changes %+v %v", changes, err)
+	}
+
+	// Appends wake up waiting readers.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		log.Append(Change{Kind: KindKV, Key: []byte("b")})
+	}()
+	if changes, _ := log.Wait(4, 10, time.Second); len(changes) != 1 || string(changes[0].Key) != "b" {
+		t.Errorf("expected to wait for change 4, got %+v", changes)
+	}
+	if changes, _ := log.Wait(5, 10, 10*time.Millisecond); len(changes) != 0 {
+		t.Errorf("expected no changes, got %+v", changes)
+	}
+
+	// The sequence continues after reopening.
+	log, _ = New(db)
+	if log.Head() != 4 {
+		t.Errorf("expected head 4, got %d", log.Head())
+	}
+}
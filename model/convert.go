@@ -1,8 +1,18 @@
 package model
 
 import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/MonteCarloClub/KBD/chain_manager"
+	"github.com/MonteCarloClub/KBD/common"
 	"github.com/MonteCarloClub/KBD/kitex_gen/api"
+	"github.com/MonteCarloClub/KBD/model/changelog"
+	"github.com/MonteCarloClub/KBD/model/kbpool"
+	"github.com/MonteCarloClub/KBD/model/kvtx"
 	"github.com/MonteCarloClub/KBD/model/state"
+	"github.com/MonteCarloClub/KBD/types"
 )
 
 func StateObject2VO(obj *state.StateObject) *api.Account {
@@ -15,3 +25,252 @@ func StateObject2VO(obj *state.StateObject) *api.Account {
 		Nonce:   int64(obj.Nonce()),
 	}
 }
+
+func StateObject2VOV2(obj *state.StateObject) *api.AccountV2 {
+	if obj == nil {
+		return nil
+	}
+	return &api.AccountV2{
+		Address:     obj.Address().Hex(),
+		Balance:     obj.Balance().String(),
+		Nonce:       int64(obj.Nonce()),
+		Code:        common.ToHex(obj.Code()),
+		CodeHash:    common.ToHex(obj.CodeHash()),
+		StorageRoot: common.ToHex(obj.Root()),
+	}
+}
+
+func Proof2VO(proof [][]byte) []string {
+	nodes := make([]string, len(proof))
+	for i, node := range proof {
+		nodes[i] = common.ToHex(node)
+	}
+	return nodes
+}
+
+func Revision2VO(rev kvtx.Revision) *api.DataRevision {
+	return &api.DataRevision{
+		Version:   int64(rev.Version),
+		Timestamp: rev.Time.UnixNano() / int64(time.Millisecond),
+		Deleted:   rev.Deleted,
+		Value:     string(rev.Data),
+	}
+}
+
+// Change2VO converts a change. Account changes carry the hex address as key
+// and the hex RLP encoded account as value.
+func Change2VO(c changelog.Change) *api.Change {
+	vo := &api.Change{
+		Seq:       int64(c.Seq),
+		Timestamp: c.Time.UnixNano() / int64(time.Millisecond),
+		Kind:      string(c.Kind),
+		Deleted:   c.Deleted,
+	}
+	switch c.Kind {
+	case changelog.KindKV:
+		vo.Key = string(c.Key)
+		namespace, version := c.Namespace, int64(c.Version)
+		vo.Namespace = &namespace
+		vo.Version = &version
+		if !c.Deleted {
+			value := string(c.Value)
+			vo.Value = &value
+		}
+	case changelog.KindAccount:
+		vo.Key = common.BytesToAddress(c.Key).Hex()
+		value := common.ToHex(c.Value)
+		vo.Value = &value
+	}
+	return vo
+}
+
+func Index2VO(idx kvtx.Index) *api.IndexDef {
+	return &api.IndexDef{
+		Name:   idx.Name,
+		Prefix: idx.Prefix,
+		Path:   idx.Path,
+	}
+}
+
+func StorageEntry2VO(entry state.StorageEntry) *api.StorageEntry {
+	vo := &api.StorageEntry{
+		Key:   entry.Key.Hex(),
+		Value: entry.Value.Hex(),
+	}
+	if entry.Slot != nil {
+		slot := common.BytesToHash(entry.Slot).Hex()
+		vo.Slot = &slot
+	}
+	return vo
+}
+
+// Transaction2VO converts a transaction. extra may be nil for transactions
+// that are not part of the chain yet.
+func Transaction2VO(tx *types.Transaction, extra *chain_manager.TxExtra) *api.Transaction {
+	if tx == nil {
+		return nil
+	}
+	vo := &api.Transaction{
+		Hash:     tx.Hash().Hex(),
+		Nonce:    int64(tx.Nonce()),
+		Value:    tx.Value().String(),
+		Gas:      tx.Gas().String(),
+		GasPrice: tx.GasPrice().String(),
+		Input:    common.ToHex(tx.Data()),
+	}
+	if from, err := tx.From(); err == nil {
+		hex := from.Hex()
+		vo.From = &hex
+	}
+	if to := tx.To(); to != nil {
+		hex := to.Hex()
+		vo.To = &hex
+	}
+	if extra != nil {
+		blockHash := extra.BlockHash.Hex()
+		blockNumber := int64(extra.BlockIndex)
+		index := int64(extra.Index)
+		vo.BlockHash = &blockHash
+		vo.BlockNumber = &blockNumber
+		vo.TransactionIndex = &index
+	}
+	return vo
+}
+
+func Log2VO(log *state.Log) *api.Log {
+	topics := make([]string, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = topic.Hex()
+	}
+	return &api.Log{
+		Address:     log.Address.Hex(),
+		Topics:      topics,
+		Data:        common.ToHex(log.Data),
+		BlockNumber: int64(log.Number),
+		TxHash:      log.TxHash.Hex(),
+		TxIndex:     int64(log.TxIndex),
+		BlockHash:   log.BlockHash.Hex(),
+		LogIndex:    int64(log.Index),
+	}
+}
+
+// Receipt2VO converts a receipt. gasUsed may be nil when it can't be derived
+// from the previous receipt in the block.
+func Receipt2VO(receipt *types.Receipt, extra *chain_manager.TxExtra, gasUsed *big.Int) *api.Receipt {
+	if receipt == nil {
+		return nil
+	}
+	logs := make([]*api.Log, len(receipt.Logs()))
+	for i, log := range receipt.Logs() {
+		logs[i] = Log2VO(log)
+	}
+	vo := &api.Receipt{
+		TxHash:            receipt.TxHash.Hex(),
+		CumulativeGasUsed: receipt.CumulativeGasUsed.String(),
+		Logs:              logs,
+		LogsBloom:         common.ToHex(receipt.Bloom.Bytes()),
+		PostState:         common.ToHex(receipt.PostState),
+	}
+	if extra != nil {
+		vo.BlockHash = extra.BlockHash.Hex()
+		vo.BlockNumber = int64(extra.BlockIndex)
+		vo.TransactionIndex = int64(extra.Index)
+	}
+	if gasUsed != nil {
+		used := gasUsed.String()
+		vo.GasUsed = &used
+	}
+	if receipt.ContractAddress != (common.Address{}) {
+		addr := receipt.ContractAddress.Hex()
+		vo.ContractAddress = &addr
+	}
+	return vo
+}
+
+// Block2VO converts a block. Full transaction objects are only included if
+// fullTx is set, the transaction hashes always are.
+func Block2VO(block *types.Block, fullTx bool) *api.Block {
+	if block == nil {
+		return nil
+	}
+	header := block.Header()
+	vo := &api.Block{
+		Hash:              block.Hash().Hex(),
+		ParentHash:        header.ParentHash.Hex(),
+		Number:            int64(block.NumberU64()),
+		Nonce:             common.ToHex(header.Nonce[:]),
+		MixDigest:         header.MixDigest.Hex(),
+		Coinbase:          header.Coinbase.Hex(),
+		StateRoot:         header.Root.Hex(),
+		TransactionsRoot:  header.TxHash.Hex(),
+		ReceiptsRoot:      header.ReceiptHash.Hex(),
+		UncleHash:         header.UncleHash.Hex(),
+		LogsBloom:         common.ToHex(header.Bloom.Bytes()),
+		Difficulty:        header.Difficulty.String(),
+		GasLimit:          header.GasLimit.String(),
+		GasUsed:           header.GasUsed.String(),
+		Timestamp:         int64(header.Time),
+		ExtraData:         common.ToHex(header.Extra),
+		TransactionHashes: make([]string, len(block.Transactions())),
+	}
+	if block.Td != nil {
+		td := block.Td.String()
+		vo.TotalDifficulty = &td
+	}
+	for i, tx := range block.Transactions() {
+		vo.TransactionHashes[i] = tx.Hash().Hex()
+		if fullTx {
+			vo.Transactions = append(vo.Transactions, Transaction2VO(tx, &chain_manager.TxExtra{
+				BlockHash:  block.Hash(),
+				BlockIndex: block.NumberU64(),
+				Index:      uint64(i),
+			}))
+		}
+	}
+	return vo
+}
+
+var queuedReasons = map[kbpool.QueuedReason]api.QueuedTxReason{
+	kbpool.QueuedPromotable:    api.QueuedTxReason_PROMOTABLE,
+	kbpool.QueuedNonceGap:      api.QueuedTxReason_NONCE_GAP,
+	kbpool.QueuedAwaitingFunds: api.QueuedTxReason_AWAITING_FUNDS,
+	kbpool.QueuedDropped:       api.QueuedTxReason_DROPPED,
+}
+
+// PoolContent2VO converts tx pool content grouped by sender. reasons is nil
+// for pending transactions.
+func PoolContent2VO(content map[common.Address]types.Transactions, reasons map[common.Hash]kbpool.QueuedReason) map[string][]*api.PoolTransaction {
+	vo := make(map[string][]*api.PoolTransaction, len(content))
+	for addr, txs := range content {
+		list := make([]*api.PoolTransaction, len(txs))
+		for i, tx := range txs {
+			list[i] = &api.PoolTransaction{Transaction: Transaction2VO(tx, nil)}
+			if reason, ok := reasons[tx.Hash()]; ok {
+				list[i].Reason = api.QueuedTxReasonPtr(queuedReasons[reason])
+			}
+		}
+		vo[addr.Hex()] = list
+	}
+	return vo
+}
+
+// PoolInspect2VO summarises tx pool content grouped by sender, one line per
+// transaction.
+func PoolInspect2VO(content map[common.Address]types.Transactions, reasons map[common.Hash]kbpool.QueuedReason) map[string][]string {
+	vo := make(map[string][]string, len(content))
+	for addr, txs := range content {
+		list := make([]string, len(txs))
+		for i, tx := range txs {
+			to := "contract creation"
+			if tx.To() != nil {
+				to = tx.To().Hex()
+			}
+			list[i] = fmt.Sprintf("%d: %s: %v wei + %v gas x %v wei", tx.Nonce(), to, tx.Value(), tx.Gas(), tx.GasPrice())
+			if reason, ok := reasons[tx.Hash()]; ok {
+				list[i] += fmt.Sprintf(" (%v)", queuedReasons[reason])
+			}
+		}
+		vo[addr.Hex()] = list
+	}
+	return vo
+}
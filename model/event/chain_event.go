@@ -3,12 +3,16 @@ package event
 import (
 	"math/big"
 
+	"github.com/MonteCarloClub/KBD/model/changelog"
 	"github.com/MonteCarloClub/KBD/model/state"
 
 	"github.com/MonteCarloClub/KBD/common"
 	"github.com/MonteCarloClub/KBD/types"
 )
 
+// ChangeEvent is posted when a change has been appended to the change log.
+type ChangeEvent struct{ Change *changelog.Change }
+
 // TxPreEvent is posted when a transaction enters the transaction pool.
 type TxPreEvent struct{ Tx *types.Transaction }
 
@@ -7,7 +7,6 @@ package kbpool
 
 import (
 	"errors"
-	"fmt"
 	"math/big"
 	"sort"
 	"sync"
@@ -32,6 +31,7 @@ var (
 	ErrIntrinsicGas       = errors.New("Intrinsic gas too low")
 	ErrGasLimit           = errors.New("Exceeds block gas limit")
 	ErrNegativeValue      = errors.New("Negative value")
+	ErrKnownTx            = errors.New("Known transaction")
 )
 
 const (
@@ -209,7 +209,7 @@ func (self *TxPool) add(tx *types.Transaction) error {
 	hash := tx.Hash()
 
 	if self.pending[hash] != nil {
-		return fmt.Errorf("Known transaction (%x)", hash[:4])
+		return ErrKnownTx
 	}
 	err := self.validateTx(tx)
 	if err != nil {
@@ -271,13 +271,17 @@ func (self *TxPool) Add(tx *types.Transaction) (err error) {
 }
 
 // AddTransactions attempts to queue all valid transactions in txs.
-func (self *TxPool) AddTransactions(txs []*types.Transaction) {
+// The returned slice holds the validation error (or nil) of each
+// transaction, in the same order as txs.
+func (self *TxPool) AddTransactions(txs []*types.Transaction) []error {
 	self.mu.Lock()
 	defer self.mu.Unlock()
 
-	for _, tx := range txs {
+	errs := make([]error, len(txs))
+	for i, tx := range txs {
 		if err := self.add(tx); err != nil {
 			klog.Error("tx error:", err)
+			errs[i] = err
 		} else {
 			h := tx.Hash()
 			klog.Infof("tx %x\n", h[:4])
@@ -286,6 +290,8 @@ func (self *TxPool) AddTransactions(txs []*types.Transaction) {
 
 	// check and validate the queueue
 	self.checkQueue()
+
+	return errs
 }
 
 // GetTransaction returns a transaction if it is contained in the pool
@@ -339,6 +345,114 @@ func (self *TxPool) GetQueuedTransactions() types.Transactions {
 	return ret
 }
 
+// Content returns the pending and queued transactions of the pool grouped
+// by sender and sorted by nonce.
+func (self *TxPool) Content() (pending, queued map[common.Address]types.Transactions) {
+	self.mu.RLock()
+	defer self.mu.RUnlock()
+
+	pending = make(map[common.Address]types.Transactions)
+	for _, tx := range self.pending {
+		from, _ := tx.From() // already validated
+		pending[from] = append(pending[from], tx)
+	}
+	queued = make(map[common.Address]types.Transactions)
+	for addr, txs := range self.queue {
+		for _, tx := range txs {
+			queued[addr] = append(queued[addr], tx)
+		}
+	}
+	for _, txs := range pending {
+		sort.Sort(types.TxByNonce{Transactions: txs})
+	}
+	for _, txs := range queued {
+		sort.Sort(types.TxByNonce{Transactions: txs})
+	}
+	return pending, queued
+}
+
+// QueuedReason describes why a queued transaction has not been moved to
+// the pending set.
+type QueuedReason byte
+
+const (
+	QueuedPromotable    QueuedReason = iota // will be promoted on the next queue check
+	QueuedNonceGap                          // a lower nonce of the sender is missing
+	QueuedAwaitingFunds                     // the sender can't cover the cost of its queued txs up to this one
+	QueuedDropped                           // stale nonce or over the queue limit, dropped on the next queue check
+)
+
+// QueuedReasons returns the reason each queued transaction is not yet
+// processable, keyed by transaction hash.
+func (self *TxPool) QueuedReasons() map[common.Hash]QueuedReason {
+	self.mu.RLock()
+	defer self.mu.RUnlock()
+
+	reasons := make(map[common.Hash]QueuedReason)
+	for addr, txs := range self.queue {
+		addq, plan := self.planQueue(addr, txs)
+		for i, e := range addq {
+			reasons[e.hash] = plan[i]
+		}
+	}
+	return reasons
+}
+
+// planQueue decides what the next queue check does with each queued
+// transaction of addr. It returns the transactions sorted by nonce along
+// with the reason for each; only QueuedPromotable ones are moved to the
+// pending set and QueuedDropped ones are removed. It doesn't modify the pool.
+func (pool *TxPool) planQueue(addr common.Address, txs map[common.Hash]*types.Transaction) (txQueue, []QueuedReason) {
+	// true nonce is the nonce known by the last state
+	trueNonce := pool.currentState().GetNonce(addr)
+	// expected nonce starts at the nonce currently kept by the tx pool
+	// (pending state) and advances as transactions get promoted
+	expected := pool.pendingState.GetNonce(addr)
+	balance := new(big.Int).Set(pool.currentState().GetBalance(addr))
+
+	var addq txQueue
+	for hash, tx := range txs {
+		addq = append(addq, txQueueEntry{hash, addr, tx})
+	}
+	sort.Sort(addq)
+
+	plan := make([]QueuedReason, len(addq))
+	var n int // transactions left after dropping stale ones
+	for i, e := range addq {
+		switch {
+		case e.Nonce() < trueNonce:
+			// processed already
+			plan[i] = QueuedDropped
+			continue
+		case n > maxQueued:
+			// exceeds the limit
+			plan[i] = QueuedDropped
+		case e.Nonce() > expected:
+			// hold the rest behind the gap, keeping at most maxQueued of them
+			for j := i; j < len(addq); j++ {
+				if j-i < maxQueued {
+					plan[j] = QueuedNonceGap
+				} else {
+					plan[j] = QueuedDropped
+				}
+			}
+			return addq, plan
+		case balance.Cmp(e.Cost()) < 0:
+			// the cost is cumulative over the promoted transactions;
+			// expected doesn't advance, so later nonces wait behind this one
+			plan[i] = QueuedAwaitingFunds
+		default:
+			plan[i] = QueuedPromotable
+			balance.Sub(balance, e.Cost())
+			if e.Nonce() == expected {
+				expected++
+			}
+		}
+		n++
+	}
+	return addq, plan
+}
+
 // RemoveTransactions removes all given transactions from the pool.
 func (self *TxPool) RemoveTransactions(txs types.Transactions) {
 	self.mu.Lock()
@@ -367,46 +481,16 @@ func (pool *TxPool) removeTx(hash common.Hash) {
 
 // checkQueue moves transactions that have become processable to main pool.
 func (pool *TxPool) checkQueue() {
-	state := pool.pendingState
-
-	var addq txQueue
 	for address, txs := range pool.queue {
-		// guessed nonce is the nonce currently kept by the tx pool (pending state)
-		guessedNonce := state.GetNonce(address)
-		// true nonce is the nonce known by the last state
-		trueNonce := pool.currentState().GetNonce(address)
-		addq := addq[:0]
-		for hash, tx := range txs {
-			if tx.Nonce() < trueNonce {
-				// Drop queued transactions whose nonce is lower than
-				// the account nonce because they have been processed.
-				delete(txs, hash)
-			} else {
-				// Collect the remaining transactions for the next pass.
-				addq = append(addq, txQueueEntry{hash, address, tx})
-			}
-		}
-		// Find the next consecutive nonce range starting at the
-		// current account nonce.
-		sort.Sort(addq)
+		addq, plan := pool.planQueue(address, txs)
 		for i, e := range addq {
-			// start deleting the transactions from the queue if they exceed the limit
-			if i > maxQueued {
-				delete(pool.queue[address], e.hash)
-				continue
-			}
-
-			if e.Nonce() > guessedNonce {
-				if len(addq)-i > maxQueued {
-					klog.Infof("Queued tx limit exceeded for %s. Tx %s removed\n", common.PP(address[:]), common.PP(e.hash[:]))
-					for j := i + maxQueued; j < len(addq); j++ {
-						delete(txs, addq[j].hash)
-					}
-				}
-				break
+			switch plan[i] {
+			case QueuedDropped:
+				delete(txs, e.hash)
+			case QueuedPromotable:
+				delete(txs, e.hash)
+				pool.addTx(e.hash, address, e.Transaction)
 			}
-			delete(txs, e.hash)
-			pool.addTx(e.hash, address, e.Transaction)
 		}
 		// Delete the entire queue entry if it became empty.
 		if len(txs) == 0 {
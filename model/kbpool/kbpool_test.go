@@ -67,7 +67,7 @@ func TestTransactionQueue(t *testing.T) {
 	pool, key := setupTxPool()
 	tx := transaction(0, big.NewInt(100), key)
 	from, _ := tx.From()
-	pool.currentState().AddBalance(from, big.NewInt(1))
+	pool.currentState().AddBalance(from, big.NewInt(1000))
 	pool.queueTx(tx.Hash(), tx)
 
 	pool.checkQueue()
@@ -96,6 +96,7 @@ func TestTransactionQueue(t *testing.T) {
 	pool.queueTx(tx2.Hash(), tx2)
 	pool.queueTx(tx3.Hash(), tx3)
 	from, _ = tx1.From()
+	pool.currentState().AddBalance(from, big.NewInt(1000))
 
 	pool.checkQueue()
 
@@ -211,3 +212,99 @@ func TestMissingNonce(t *testing.T) {
 		t.Error("expected 1 queued transaction, got", len(pool.queue[addr]))
 	}
 }
+
+func TestAddTransactionsErrors(t *testing.T) {
+	pool, key := setupTxPool()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	pool.currentState().AddBalance(addr, big.NewInt(100000000000000))
+
+	tx := transaction(0, big.NewInt(100000), key)
+	if err := pool.Add(tx); err != nil {
+		t.Error("didn't expect error", err)
+	}
+
+	errs := pool.AddTransactions([]*types.Transaction{tx, transaction(1, big.NewInt(100), key), transaction(1, big.NewInt(100000), key)})
+	if len(errs) != 3 {
+		t.Fatal("expected 3 results, got", len(errs))
+	}
+	if errs[0] != ErrKnownTx {
+		t.Error("expected", ErrKnownTx, "got", errs[0])
+	}
+	if errs[1] != ErrIntrinsicGas {
+		t.Error("expected", ErrIntrinsicGas, "got", errs[1])
+	}
+	if errs[2] != nil {
+		t.Error("didn't expect error", errs[2])
+	}
+}
+
+func TestQueuedReasons(t *testing.T) {
+	pool, key := setupTxPool()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	pool.currentState().AddBalance(addr, big.NewInt(100000))
+
+	gap := transaction(2, big.NewInt(100000), key)
+	cheap := transaction(0, big.NewInt(100), key)
+	costly := transaction(0, big.NewInt(1000000), key)
+	pool.queueTx(gap.Hash(), gap)
+	pool.queueTx(cheap.Hash(), cheap)
+	pool.queueTx(costly.Hash(), costly)
+
+	reasons := pool.QueuedReasons()
+	if reasons[gap.Hash()] != QueuedNonceGap {
+		t.Error("expected nonce gap, got", reasons[gap.Hash()])
+	}
+	if reasons[cheap.Hash()] != QueuedPromotable {
+		t.Error("expected promotable, got", reasons[cheap.Hash()])
+	}
+	if reasons[costly.Hash()] != QueuedAwaitingFunds {
+		t.Error("expected awaiting funds, got", reasons[costly.Hash()])
+	}
+
+	pending, queued := pool.Content()
+	if len(pending) != 0 {
+		t.Error("expected no pending senders, got", len(pending))
+	}
+	if txs := queued[addr]; len(txs) != 3 || txs[2].Nonce() != 2 {
+		t.Error("expected 3 queued txs sorted by nonce, got", txs)
+	}
+}
+
+func TestQueuedReasonsMatchCheckQueue(t *testing.T) {
+	pool, key := setupTxPool()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	pool.currentState().AddBalance(addr, big.NewInt(100000))
+	pool.currentState().SetNonce(addr, 1)
+	pool.resetState()
+
+	// each of the non-stale txs costs 60000, so only the first one is covered
+	stale := transaction(0, big.NewInt(100), key)
+	first := transaction(1, big.NewInt(59900), key)
+	second := transaction(2, big.NewInt(59900), key)
+	third := transaction(3, big.NewInt(100), key)
+	for _, tx := range []*types.Transaction{stale, first, second, third} {
+		pool.queueTx(tx.Hash(), tx)
+	}
+
+	reasons := pool.QueuedReasons()
+	expected := map[common.Hash]QueuedReason{
+		stale.Hash():  QueuedDropped,
+		first.Hash():  QueuedPromotable,
+		second.Hash(): QueuedAwaitingFunds,
+		third.Hash():  QueuedNonceGap,
+	}
+	for hash, reason := range expected {
+		if reasons[hash] != reason {
+			t.Errorf("tx %x: expected reason %d, got %d", hash[:4], reason, reasons[hash])
+		}
+	}
+
+	pool.checkQueue()
+	if len(pool.pending) != 1 || pool.pending[first.Hash()] == nil {
+		t.Error("expected only the promotable tx to be pending, got", pool.pending)
+	}
+	queued := pool.queue[addr]
+	if len(queued) != 2 || queued[second.Hash()] == nil || queued[third.Hash()] == nil {
+		t.Error("expected the underfunded and gapped txs to stay queued, got", queued)
+	}
+}
@@ -1,22 +1,78 @@
 package kdb
 
 import (
+	"bytes"
+	"os"
+	"path"
 	"sync"
 	"time"
 
-	"github.com/MonteCarloClub/KBD/compression/rle"
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/compression/codec"
 	"github.com/cloudwego/kitex/pkg/klog"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
+// Durability is how far a write has got when Put returns.
+type Durability int
+
+const (
+	// DurabilityQueue keeps writes in memory until the next periodic flush.
+	DurabilityQueue Durability = iota
+	// DurabilityWAL appends writes to an fsynced write-ahead log, which is
+	// replayed when the database is opened again.
+	DurabilityWAL
+	// DurabilitySync writes straight to leveldb with the sync option.
+	DurabilitySync
+)
+
+var syncWrite = &opt.WriteOptions{Sync: true}
+
+// DefaultCacheSize is how many decompressed values an LDBDatabase keeps for
+// reads.
+const DefaultCacheSize = 8192
+
 type LDBDatabase struct {
 	fn string
 
-	mu sync.Mutex
-	db *leveldb.DB
+	mu    sync.RWMutex
+	db    *leveldb.DB
+	cache *lru.Cache
+	codec codec.Codec
+
+	queue      map[string][]byte
+	queueBytes int
+	queuedAt   time.Time
+	durability Durability
+	wal        *wal
 
-	queue map[string][]byte
+	// writeMu serializes the writes which are logged or bypass the queue,
+	// so that mu is not held while they wait for the disk.
+	writeMu sync.Mutex
+
+	// formatMu orders the upgrade of a database written before codecs with
+	// the reads and writes of leveldb, see format.
+	formatMu    sync.RWMutex
+	format      format
+	upgradeStop chan struct{}
+	upgradeDone chan struct{}
+
+	// flushing holds the writes of the queue being flushed, until they are
+	// in leveldb. flushMu serializes flushes.
+	flushing map[string][]byte
+	flushed  *sync.Cond
+	flushMu  sync.Mutex
+
+	flushConfig FlushConfig
+	drained     *sync.Cond
+	waiting     int
+	flushc      chan struct{}
+	closed      bool
+	metrics     dbMetrics
 
 	quit chan struct{}
 }
@@ -27,56 +83,214 @@ func NewLDBDatabase(file string) (*LDBDatabase, error) {
 	if err != nil {
 		return nil, err
 	}
+	cache, _ := lru.New(DefaultCacheSize)
 	database := &LDBDatabase{
-		fn:   file,
-		db:   db,
-		quit: make(chan struct{}),
+		fn:          file,
+		db:          db,
+		cache:       cache,
+		codec:       codec.RLE{},
+		flushConfig: DefaultFlushConfig.withDefaults(),
+		flushc:      make(chan struct{}, 1),
+		metrics:     newDBMetrics(path.Base(file)),
+		upgradeStop: make(chan struct{}),
+		upgradeDone: make(chan struct{}),
+		quit:        make(chan struct{}),
 	}
+	database.drained = sync.NewCond(&database.mu)
+	database.flushed = sync.NewCond(&database.mu)
 	database.makeQueue()
+	if err := database.openFormat(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if err := database.replayWAL(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if database.format.upgrading {
+		go database.upgradeFormat()
+	} else {
+		close(database.upgradeDone)
+	}
 
 	go database.update()
 
 	return database, nil
 }
 
-func (self *LDBDatabase) makeQueue() {
-	self.queue = make(map[string][]byte)
+func (self *LDBDatabase) walFile() string {
+	return self.fn + ".wal"
+}
+
+// replayWAL applies the writes left in the log by a previous run. A log
+// rotated out by a flush that did not finish holds older writes and is
+// applied first.
+func (self *LDBDatabase) replayWAL() error {
+	if old := oldWALFile(self.walFile()); common.FileExist(old) {
+		w, err := openWAL(old)
+		if err != nil {
+			return err
+		}
+		err = self.replay(w)
+		w.close()
+		if err != nil {
+			return err
+		}
+		if err := self.Flush(); err != nil {
+			return err
+		}
+		if err := os.Remove(old); err != nil {
+			return err
+		}
+	}
+	if !common.FileExist(self.walFile()) {
+		return nil
+	}
+	w, err := openWAL(self.walFile())
+	if err != nil {
+		return err
+	}
+	if err := self.replay(w); err != nil {
+		w.close()
+		return err
+	}
+	self.wal = w
+	return self.Flush()
+}
+
+// replay queues the writes in w, deletes are applied straight away.
+func (self *LDBDatabase) replay(w *wal) error {
+	err := w.replay(func(r walRecord) {
+		switch r.op {
+		case walPut:
+			self.enqueue(r.key, r.value)
+		case walDelete:
+			self.dequeue(r.key)
+			self.db.Delete(r.key, nil)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	klog.Infof("[replayWAL] replayed %d writes from %v", len(self.queue), w.file)
+	return nil
+}
+
+// SetDurability sets how writes are persisted from now on.
+func (self *LDBDatabase) SetDurability(durability Durability) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if durability == DurabilityWAL && self.wal == nil {
+		w, err := openWAL(self.walFile())
+		if err != nil {
+			return err
+		}
+		self.wal = w
+	}
+	self.durability = durability
+	return nil
 }
 
+// Put queues a write, or writes it straight to leveldb with DurabilitySync.
+// Logging and syncing it happen outside of mu, so reads do not wait for the
+// disk.
 func (self *LDBDatabase) Put(key []byte, value []byte) error {
 	klog.Infof("[Put] key = %v value = %v", key, value)
 	self.mu.Lock()
+	switch self.durability {
+	case DurabilitySync:
+		self.mu.Unlock()
+		return self.writeBatch([]walRecord{{op: walPut, key: key, value: value}})
+	case DurabilityWAL:
+		self.waitQueue(len(key) + len(value))
+		w := self.wal
+		self.mu.Unlock()
+
+		self.writeMu.Lock()
+		defer self.writeMu.Unlock()
+		if err := w.append(walRecord{op: walPut, key: key, value: value}); err != nil {
+			return err
+		}
+		self.mu.Lock()
+	default:
+		self.waitQueue(len(key) + len(value))
+	}
 	defer self.mu.Unlock()
-	self.queue[string(key)] = value
+	self.cache.Remove(string(key))
+	self.enqueue(key, value)
 	return nil
 }
 
+// Get looks in the write queue, then in the cache and then in leveldb. Reads
+// only take the read lock, writers invalidate the cached values they change.
 func (self *LDBDatabase) Get(key []byte) ([]byte, error) {
-	self.mu.Lock()
-	defer self.mu.Unlock()
-	klog.Infof("[Get] key = %v", key)
+	self.mu.RLock()
+	defer self.mu.RUnlock()
 	// Check queue first
 	if dat, ok := self.queue[string(key)]; ok {
 		return dat, nil
 	}
+	if dat, ok := self.flushing[string(key)]; ok {
+		return dat, nil
+	}
+	if res, ok := self.cache.Get(string(key)); ok {
+		self.metrics.hit.Mark(1)
+		return common.CopyBytes(res.([]byte)), nil
+	}
+	self.metrics.miss.Mark(1)
 
+	self.formatMu.RLock()
+	defer self.formatMu.RUnlock()
 	dat, err := self.db.Get(key, nil)
 	if err != nil {
 		return nil, err
 	}
-	res, err := rle.Decompress(dat)
-	klog.Infof("[Get] key = %v value = %v", key, res)
-	return rle.Decompress(dat)
+	res, err := self.format.decode(key, dat)
+	if err != nil {
+		return nil, err
+	}
+	self.cache.Add(string(key), common.CopyBytes(res))
+	return res, nil
+}
+
+// SetCacheSize sets how many values are cached for reads, at least one.
+func (self *LDBDatabase) SetCacheSize(size int) {
+	if size < 1 {
+		size = 1
+	}
+	self.cache.Resize(size)
 }
 
 func (self *LDBDatabase) Delete(key []byte) error {
-	self.mu.Lock()
-	defer self.mu.Unlock()
+	return self.writeBatch([]walRecord{{op: walDelete, key: key}})
+}
 
-	// make sure it's not in the queue
-	delete(self.queue, string(key))
+// waitFlushing waits until the flush in progress is done if it writes any of
+// keys, which would otherwise overwrite a write bypassing the queue. Callers
+// hold mu, which is released while waiting.
+func (self *LDBDatabase) waitFlushing(keys ...[]byte) {
+	for self.flushing != nil {
+		found := false
+		for _, key := range keys {
+			if _, ok := self.flushing[string(key)]; ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return
+		}
+		self.flushed.Wait()
+	}
+}
 
-	return self.db.Delete(key, nil)
+// writeOptions returns the options for writes that bypass the queue.
+func (self *LDBDatabase) writeOptions() *opt.WriteOptions {
+	if self.durability == DurabilitySync {
+		return syncWrite
+	}
+	return nil
 }
 
 func (self *LDBDatabase) LastKnownTD() []byte {
@@ -89,28 +303,296 @@ func (self *LDBDatabase) LastKnownTD() []byte {
 	return data
 }
 
-func (self *LDBDatabase) NewIterator() iterator.Iterator {
-	return self.db.NewIterator(nil, nil)
+// WriteBatch puts the key/value pairs and removes the deleted keys in a
+// single leveldb batch, bypassing the write queue.
+func (self *LDBDatabase) WriteBatch(keys, values, deleted [][]byte) error {
+	batch := self.NewBatch()
+	for i, key := range keys {
+		batch.Put(key, values[i])
+	}
+	for _, key := range deleted {
+		batch.Delete(key)
+	}
+	return batch.Write()
 }
 
-func (self *LDBDatabase) Flush() error {
+// ldbBatch collects the writes of a batch as log records.
+type ldbBatch struct {
+	db      *LDBDatabase
+	records []walRecord
+}
+
+// NewBatch returns a batch which is written to leveldb in one go, bypassing
+// the write queue.
+func (self *LDBDatabase) NewBatch() common.Batch {
+	return &ldbBatch{db: self}
+}
+
+func (self *ldbBatch) Put(key, value []byte) error {
+	self.records = append(self.records, walRecord{op: walPut, key: common.CopyBytes(key), value: common.CopyBytes(value)})
+	return nil
+}
+
+func (self *ldbBatch) Delete(key []byte) error {
+	self.records = append(self.records, walRecord{op: walDelete, key: common.CopyBytes(key)})
+	return nil
+}
+
+func (self *ldbBatch) Write() error {
+	return self.db.writeBatch(self.records)
+}
+
+func (self *ldbBatch) Reset() {
+	self.records = nil
+}
+
+// writeBatch writes records straight to leveldb, logging them first if the
+// database has a WAL. mu is only held to look at and update the queue and
+// the cache, not while the batch goes to disk.
+func (self *LDBDatabase) writeBatch(records []walRecord) error {
+	self.writeMu.Lock()
+	defer self.writeMu.Unlock()
+
+	self.mu.Lock()
+	keys := make([][]byte, len(records))
+	for i, r := range records {
+		keys[i] = r.key
+	}
+	self.waitFlushing(keys...)
+	c, w, options := self.codec, self.wal, self.writeOptions()
+	if self.durability != DurabilityWAL {
+		w = nil
+	}
+	self.mu.Unlock()
+
+	if w != nil && len(records) > 0 {
+		// Log the batch too, the log may still hold older writes to the
+		// same keys which would otherwise win on replay.
+		if err := w.append(records...); err != nil {
+			return err
+		}
+	}
+	if err := self.write(records, c, options); err != nil {
+		return err
+	}
+
+	// The queue may hold older writes to the keys, and readers may have
+	// cached the values they replace.
 	self.mu.Lock()
 	defer self.mu.Unlock()
+	for _, r := range records {
+		self.dequeue(r.key)
+		self.cache.Remove(string(r.key))
+	}
+	return nil
+}
+
+// write encodes records with c and writes them to leveldb.
+func (self *LDBDatabase) write(records []walRecord, c codec.Codec, options *opt.WriteOptions) error {
+	self.formatMu.RLock()
+	defer self.formatMu.RUnlock()
+
 	batch := new(leveldb.Batch)
+	for _, r := range records {
+		if r.op == walPut {
+			batch.Put(r.key, self.format.encode(c, r.key, r.value))
+		} else {
+			batch.Delete(r.key)
+		}
+	}
+	return self.db.Write(batch, options)
+}
 
-	for key, value := range self.queue {
-		resKey := []byte(key)
-		klog.Infof("[Flush] key = %v value = %v", resKey, value)
-		batch.Put([]byte(key), rle.Compress(value))
+// FlushBatch writes batch straight to leveldb, like WriteBatch.
+func (self *LDBDatabase) FlushBatch(batch *leveldb.Batch) error {
+	records := &batchRecords{}
+	if err := batch.Replay(records); err != nil {
+		return err
 	}
-	self.makeQueue() // reset the queue
+	return self.writeBatch(records.records)
+}
 
-	return self.db.Write(batch, nil)
+// batchRecords collects the writes of a leveldb batch.
+type batchRecords struct {
+	records []walRecord
 }
-func (self *LDBDatabase) FlushBatch(batch *leveldb.Batch) error {
-	klog.Infof("FlushBatch batch = %v", batch)
-	err := self.db.Write(batch, nil)
-	return err
+
+func (self *batchRecords) Put(key, value []byte) {
+	self.records = append(self.records, walRecord{op: walPut, key: common.CopyBytes(key), value: common.CopyBytes(value)})
+}
+
+func (self *batchRecords) Delete(key []byte) {
+	self.records = append(self.records, walRecord{op: walDelete, key: common.CopyBytes(key)})
+}
+
+// NewIterator iterates the entries whose keys have the given prefix, from
+// start on if start is not empty. The write queue is flushed first.
+func (self *LDBDatabase) NewIterator(prefix, start []byte) common.Iterator {
+	if err := self.Flush(); err != nil {
+		return &errIterator{err: err}
+	}
+	self.formatMu.RLock()
+	defer self.formatMu.RUnlock()
+	return &ldbIterator{Iterator: self.db.NewIterator(prefixRange(prefix, start), nil), format: self.format}
+}
+
+// NewSnapshot flushes the write queue and returns a read-only view of the
+// database as it is then.
+func (self *LDBDatabase) NewSnapshot() (Snapshot, error) {
+	if err := self.Flush(); err != nil {
+		return nil, err
+	}
+	self.formatMu.RLock()
+	defer self.formatMu.RUnlock()
+	snap, err := self.db.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return &ldbSnapshot{snap: snap, format: self.format}, nil
+}
+
+type ldbSnapshot struct {
+	snap   *leveldb.Snapshot
+	format format
+}
+
+func (self *ldbSnapshot) Get(key []byte) ([]byte, error) {
+	dat, err := self.snap.Get(key, nil)
+	if err != nil {
+		return nil, err
+	}
+	return self.format.decode(key, dat)
+}
+
+func (self *ldbSnapshot) NewIterator(prefix, start []byte) common.Iterator {
+	return &ldbIterator{Iterator: self.snap.NewIterator(prefixRange(prefix, start), nil), format: self.format}
+}
+
+func (self *ldbSnapshot) Release() {
+	self.snap.Release()
+}
+
+func prefixRange(prefix, start []byte) *util.Range {
+	slice := util.BytesPrefix(prefix)
+	if bytes.Compare(start, slice.Start) > 0 {
+		slice.Start = start
+	}
+	return slice
+}
+
+// ldbIterator decompresses the values of a leveldb iterator.
+type ldbIterator struct {
+	iterator.Iterator
+	format format
+	value  []byte
+	err    error
+}
+
+func (self *ldbIterator) Next() bool {
+	if self.err != nil || !self.Iterator.Next() {
+		return false
+	}
+	if bytes.Equal(self.Iterator.Key(), formatKey) && !self.Iterator.Next() {
+		return false
+	}
+	self.value, self.err = self.format.decode(self.Iterator.Key(), self.Iterator.Value())
+	return self.err == nil
+}
+
+func (self *ldbIterator) Value() []byte {
+	return self.value
+}
+
+func (self *ldbIterator) Error() error {
+	if self.err != nil {
+		return self.err
+	}
+	return self.Iterator.Error()
+}
+
+// errIterator is an empty iterator failing with err.
+type errIterator struct {
+	err error
+}
+
+func (self *errIterator) Next() bool    { return false }
+func (self *errIterator) Key() []byte   { return nil }
+func (self *errIterator) Value() []byte { return nil }
+func (self *errIterator) Error() error  { return self.err }
+func (self *errIterator) Release()      {}
+
+// Scan returns up to limit entries with the given prefix in the key range
+// [start, end), empty bounds being open. The write queue is flushed first.
+// next is the key to resume from, nil once the range is exhausted.
+func (self *LDBDatabase) Scan(prefix, start, end []byte, limit int) (keys, values [][]byte, next []byte, err error) {
+	return scan(self.NewIterator(prefix, start), end, limit)
+}
+
+// Flush writes the queued writes to leveldb and returns once they are on
+// disk. The queue is swapped out under the lock and written outside of it,
+// reads are served from the swapped out writes until they are in leveldb.
+func (self *LDBDatabase) Flush() error {
+	self.flushMu.Lock()
+	defer self.flushMu.Unlock()
+	defer self.metrics.flush.UpdateSince(time.Now())
+
+	// Logged writes hold writeMu until they are queued, so the rotated out
+	// log holds exactly the writes of the swapped out queue.
+	self.writeMu.Lock()
+	self.mu.Lock()
+	queue, c, w := self.queue, self.codec, self.wal
+	if len(queue) == 0 && (w == nil || w.empty()) {
+		self.mu.Unlock()
+		self.writeMu.Unlock()
+		return nil
+	}
+	if w != nil {
+		if err := w.rotate(); err != nil {
+			self.mu.Unlock()
+			self.writeMu.Unlock()
+			return err
+		}
+	}
+	self.flushing = queue
+	self.makeQueue() // reset the queue
+	self.mu.Unlock()
+	self.writeMu.Unlock()
+
+	records := make([]walRecord, 0, len(queue))
+	for key, value := range queue {
+		records = append(records, walRecord{op: walPut, key: []byte(key), value: value})
+	}
+	err := self.write(records, c, syncWrite)
+
+	self.mu.Lock()
+	self.flushing = nil
+	self.flushed.Broadcast()
+	if err != nil {
+		// Queue the writes again, unless they have been overwritten since.
+		for key, value := range queue {
+			if _, ok := self.queue[key]; !ok {
+				self.enqueue([]byte(key), value)
+			}
+		}
+		self.mu.Unlock()
+		if w != nil {
+			self.writeMu.Lock()
+			if err := w.restore(); err != nil {
+				klog.Errorf("[Flush] restore wal of %v failed %v", self.fn, err)
+			}
+			self.writeMu.Unlock()
+		}
+		return err
+	}
+	for key := range queue {
+		self.cache.Remove(key)
+	}
+	self.mu.Unlock()
+	if w == nil {
+		return nil
+	}
+	// The log can only be dropped once leveldb has the writes on disk.
+	return w.drop()
 }
 
 func (self *LDBDatabase) Close() {
@@ -120,25 +602,47 @@ func (self *LDBDatabase) Close() {
 }
 
 func (self *LDBDatabase) update() {
-	ticker := time.NewTicker(1 * time.Minute)
+	timer := time.NewTimer(0)
 done:
 	for {
 		select {
-		case <-ticker.C:
-			if err := self.Flush(); err != nil {
-				klog.Error("error: flush '%s': %v\n", self.fn, err)
+		case <-timer.C:
+		case <-self.flushc:
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
 			}
 		case <-self.quit:
 			break done
 		}
+		due, wait := self.flushDue()
+		if due {
+			if err := self.Flush(); err != nil {
+				klog.Error("error: flush '%s': %v\n", self.fn, err)
+			}
+			_, wait = self.flushDue()
+		}
+		timer.Reset(wait)
 	}
 
 	if err := self.Flush(); err != nil {
 		klog.Error("error: flush '%s': %v\n", self.fn, err)
 	}
+	self.mu.Lock()
+	self.closed = true
+	self.drained.Broadcast()
+	self.mu.Unlock()
+
+	close(self.upgradeStop)
+	<-self.upgradeDone
 
 	// Close the leveldb database
 	self.db.Close()
+	if self.wal != nil {
+		self.wal.close()
+	}
 
 	self.quit <- struct{}{}
 }
@@ -4,10 +4,15 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/compression/codec"
+	"github.com/MonteCarloClub/KBD/compression/rle"
 	"github.com/MonteCarloClub/KBD/constant"
+	"github.com/syndtr/goleveldb/leveldb"
 )
 
 func TestNewDb(t *testing.T) {
@@ -24,3 +29,385 @@ func TestNewDb(t *testing.T) {
 	res, err = db.Get([]byte("dfdsaf"))
 	fmt.Println(string(res), err)
 }
+
+func TestScan(t *testing.T) {
+	db, err := NewLDBDatabase(path.Join(t.TempDir(), "scan"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	db.Put([]byte("a/1"), []byte("1"))
+	db.WriteBatch([][]byte{[]byte("a/2"), []byte("a/3"), []byte("b/1")}, [][]byte{[]byte("2"), []byte("3"), []byte("4")}, nil)
+
+	var got []string
+	var start []byte
+	for {
+		keys, values, next, err := db.Scan([]byte("a/"), start, nil, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, key := range keys {
+			got = append(got, string(key)+"="+string(values[i]))
+		}
+		if next == nil {
+			break
+		}
+		start = next
+	}
+	if exp := "a/1=1,a/2=2,a/3=3"; strings.Join(got, ",") != exp {
+		t.Errorf("expected %s got %v", exp, got)
+	}
+
+	keys, _, _, _ := db.Scan(nil, []byte("a/2"), []byte("b/1"), 10)
+	if len(keys) != 2 {
+		t.Errorf("expected 2 keys in range, got %d", len(keys))
+	}
+}
+
+func TestWALReplay(t *testing.T) {
+	file := path.Join(t.TempDir(), "wal")
+	db, err := NewLDBDatabase(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetDurability(DurabilityWAL)
+	db.Put([]byte("a"), []byte("1"))
+	db.Put([]byte("b"), []byte("2"))
+	db.Delete([]byte("b"))
+	db.WriteBatch([][]byte{[]byte("c")}, [][]byte{[]byte("3")}, nil)
+	db.Put([]byte("c"), []byte("4"))
+
+	// Simulate a crash: the queue is never flushed.
+	db.db.Close()
+	db.wal.close()
+
+	db, err = NewLDBDatabase(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if res, err := db.db.Get([]byte("a"), nil); err != nil || string(mustDecode(t, res)) != "1" {
+		t.Errorf("expected a=1 after replay, got %q %v", res, err)
+	}
+	if _, err := db.Get([]byte("b")); err == nil {
+		t.Error("expected b to stay deleted")
+	}
+	if res, _ := db.Get([]byte("c")); string(res) != "4" {
+		t.Errorf("expected c=4 after replay, got %q", res)
+	}
+}
+
+func TestWALReplayRotated(t *testing.T) {
+	file := path.Join(t.TempDir(), "wal")
+	db, err := NewLDBDatabase(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetDurability(DurabilityWAL)
+	db.Put([]byte("a"), []byte("1"))
+	db.Put([]byte("b"), []byte("1"))
+
+	// Simulate a crash during a flush: the log has been rotated out but the
+	// writes in it never reached leveldb.
+	if err := db.wal.rotate(); err != nil {
+		t.Fatal(err)
+	}
+	db.wal.append(walRecord{op: walPut, key: []byte("a"), value: []byte("2")})
+	db.wal.append(walRecord{op: walDelete, key: []byte("b")})
+	db.db.Close()
+	db.wal.close()
+
+	db, err = NewLDBDatabase(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if res, _ := db.Get([]byte("a")); string(res) != "2" {
+		t.Errorf("expected a=2 after replay, got %q", res)
+	}
+	if _, err := db.Get([]byte("b")); err == nil {
+		t.Error("expected b to stay deleted")
+	}
+	if common.FileExist(oldWALFile(db.walFile())) {
+		t.Error("expected the rotated log to be removed")
+	}
+}
+
+func mustDecode(t *testing.T, data []byte) []byte {
+	res, err := codec.Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+func TestBatchIterator(t *testing.T) {
+	ldb, err := NewLDBDatabase(path.Join(t.TempDir(), "batch"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldb.Close()
+	mem, _ := NewMemDatabase()
+
+	for _, db := range []common.Database{ldb, mem} {
+		db.Put([]byte("a/0"), []byte("0"))
+		db.Put([]byte("a/2"), []byte("old"))
+
+		batch := db.NewBatch()
+		batch.Put([]byte("a/1"), []byte("1"))
+		batch.Put([]byte("a/2"), []byte("2"))
+		batch.Put([]byte("b/1"), []byte("3"))
+		batch.Delete([]byte("a/0"))
+		if res, _ := db.Get([]byte("a/1")); res != nil {
+			t.Errorf("%T: expected batch to be pending, got %q", db, res)
+		}
+		if err := batch.Write(); err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		it := db.NewIterator([]byte("a/"), []byte("a/1"))
+		for it.Next() {
+			got = append(got, string(it.Key())+"="+string(it.Value()))
+		}
+		it.Release()
+		if err := it.Error(); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Join(got, ",") != "a/1=1,a/2=2" {
+			t.Errorf("%T: unexpected entries %v", db, got)
+		}
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	ldb, err := NewLDBDatabase(path.Join(t.TempDir(), "snapshot"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ldb.Close()
+	mem, _ := NewMemDatabase()
+
+	for _, db := range []interface {
+		common.Database
+		NewSnapshot() (Snapshot, error)
+	}{ldb, mem} {
+		db.Put([]byte("a"), []byte("1"))
+		snap, err := db.NewSnapshot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		db.Put([]byte("a"), []byte("2"))
+		db.Put([]byte("b"), []byte("3"))
+
+		if res, err := snap.Get([]byte("a")); err != nil || string(res) != "1" {
+			t.Errorf("%T: expected a=1 in snapshot, got %q %v", db, res, err)
+		}
+		if _, err := snap.Get([]byte("b")); err == nil {
+			t.Errorf("%T: expected b to be missing from snapshot", db)
+		}
+		it := snap.NewIterator(nil, nil)
+		n := 0
+		for it.Next() {
+			n++
+		}
+		it.Release()
+		if n != 1 {
+			t.Errorf("%T: expected 1 entry in snapshot, got %d", db, n)
+		}
+		snap.Release()
+	}
+}
+
+func TestFlushTriggers(t *testing.T) {
+	db, err := NewLDBDatabase(path.Join(t.TempDir(), "flush"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	queued := func() (int, int) {
+		db.mu.Lock()
+		defer db.mu.Unlock()
+		return len(db.queue), db.queueBytes
+	}
+	waitFlushed := func() {
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+			if keys, _ := queued(); keys == 0 {
+				return
+			}
+		}
+		t.Fatal("queue was not flushed")
+	}
+
+	db.SetFlushConfig(FlushConfig{FlushKeys: 3})
+	for i := 0; i < 3; i++ {
+		db.Put([]byte{byte(i)}, []byte("v"))
+	}
+	waitFlushed()
+
+	db.SetFlushConfig(FlushConfig{FlushAge: 10 * time.Millisecond})
+	db.Put([]byte("aged"), []byte("v"))
+	waitFlushed()
+
+	// Writers wait for the flusher once the queue is full.
+	db.SetFlushConfig(FlushConfig{FlushBytes: 1 << 20, MaxQueueBytes: 100})
+	for i := 0; i < 100; i++ {
+		db.Put([]byte(fmt.Sprintf("key%03d", i)), []byte("0123456789"))
+		if _, size := queued(); size > 100 {
+			t.Fatalf("queue grew to %d bytes", size)
+		}
+	}
+	if res, err := db.Get([]byte("key099")); err != nil || string(res) != "0123456789" {
+		t.Errorf("unexpected value %q %v", res, err)
+	}
+}
+
+func TestReadCache(t *testing.T) {
+	db, err := NewLDBDatabase(path.Join(t.TempDir(), "cache"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	expect := func(want string) {
+		t.Helper()
+		res, err := db.Get([]byte("k"))
+		if want == "" && err == nil || want != "" && string(res) != want {
+			t.Errorf("expected %q, got %q %v", want, res, err)
+		}
+	}
+	db.Put([]byte("k"), []byte("1"))
+	db.Flush()
+	expect("1")
+	if !db.cache.Contains("k") {
+		t.Error("expected k to be cached")
+	}
+	expect("1")
+
+	db.Put([]byte("k"), []byte("2"))
+	db.Flush()
+	expect("2")
+	db.WriteBatch([][]byte{[]byte("k")}, [][]byte{[]byte("3")}, nil)
+	expect("3")
+	db.Delete([]byte("k"))
+	expect("")
+
+	db.Put([]byte("k"), []byte("4"))
+	db.Flush()
+	done := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for j := 0; j < 100; j++ {
+				if res, _ := db.Get([]byte("k")); string(res) != "4" {
+					t.Errorf("expected 4, got %q", res)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 4; i++ {
+		<-done
+	}
+}
+
+func TestReadDuringWrite(t *testing.T) {
+	db, err := NewLDBDatabase(path.Join(t.TempDir(), "read"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetDurability(DurabilityWAL)
+	db.Put([]byte("a"), []byte("1"))
+
+	// A write waiting for the disk holds writeMu, reads go on.
+	db.writeMu.Lock()
+	done := make(chan struct{})
+	go func() {
+		db.Put([]byte("b"), []byte("2"))
+		close(done)
+	}()
+	if res, err := db.Get([]byte("a")); err != nil || string(res) != "1" {
+		t.Errorf("expected 1, got %q %v", res, err)
+	}
+	db.writeMu.Unlock()
+	<-done
+	if res, err := db.Get([]byte("b")); err != nil || string(res) != "2" {
+		t.Errorf("expected 2, got %q %v", res, err)
+	}
+}
+
+func TestMixedCodecs(t *testing.T) {
+	db, err := NewLDBDatabase(path.Join(t.TempDir(), "codecs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	db.Put([]byte("a"), []byte("rle"))
+	db.Flush()
+	db.SetCodec(codec.Snappy{})
+	db.WriteBatch([][]byte{[]byte("b")}, [][]byte{[]byte("snappy")}, nil)
+
+	for key, id := range map[string]byte{"a": codec.IDRLE, "b": codec.IDSnappy} {
+		if raw, _ := db.db.Get([]byte(key), nil); len(raw) == 0 || raw[0] != id {
+			t.Errorf("expected %s to be stored with codec %d, got %q", key, id, raw)
+		}
+	}
+	keys, values, _, err := db.Scan(nil, nil, nil, 10)
+	if err != nil || len(keys) != 2 || string(values[0]) != "rle" || string(values[1]) != "snappy" {
+		t.Errorf("unexpected scan %q %q %v", keys, values, err)
+	}
+}
+
+func TestUpgradeFormat(t *testing.T) {
+	file := path.Join(t.TempDir(), "legacy")
+	ldb, err := leveldb.OpenFile(file, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2*upgradeBatchSize+1; i++ {
+		ldb.Put([]byte(fmt.Sprintf("%05d", i)), rle.Compress([]byte(fmt.Sprintf("v%d", i))), nil)
+	}
+	ldb.Close()
+
+	db, err := NewLDBDatabase(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// The upgrade runs in the background, values are readable and writable
+	// meanwhile.
+	db.SetCodec(codec.Snappy{})
+	last := []byte(fmt.Sprintf("%05d", 2*upgradeBatchSize))
+	db.WriteBatch([][]byte{last}, [][]byte{[]byte("new")}, nil)
+	expect := func() {
+		t.Helper()
+		for _, i := range []int{0, upgradeBatchSize} {
+			if res, err := db.Get([]byte(fmt.Sprintf("%05d", i))); err != nil || string(res) != fmt.Sprintf("v%d", i) {
+				t.Errorf("unexpected value of %d: %q %v", i, res, err)
+			}
+		}
+		if res, err := db.Get(last); err != nil || string(res) != "new" {
+			t.Errorf("unexpected value of %s: %q %v", last, res, err)
+		}
+		keys, _, _, err := db.Scan(nil, nil, nil, 3*upgradeBatchSize)
+		if err != nil || len(keys) != 2*upgradeBatchSize+1 {
+			t.Errorf("expected %d keys, got %d %v", 2*upgradeBatchSize+1, len(keys), err)
+		}
+	}
+	expect()
+
+	<-db.upgradeDone
+	db.cache.Purge()
+	expect()
+	if mark, _ := db.db.Get(formatKey, nil); string(mark) != "\x01" {
+		t.Errorf("expected upgrade to be marked done, got %q", mark)
+	}
+	if raw, _ := db.db.Get([]byte("00000"), nil); len(raw) == 0 || raw[0] != codec.IDRLE {
+		t.Errorf("expected an RLE header, got %q", raw)
+	}
+}
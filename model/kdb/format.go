@@ -0,0 +1,156 @@
+package kdb
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/compression/codec"
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// formatKey marks databases whose values start with a codec header. While a
+// database written before codecs is upgraded, it holds a zero byte followed
+// by the last upgraded key, and a one once the upgrade is done.
+var formatKey = []byte("\x00kdb-format")
+
+const upgradeBatchSize = 1000
+
+// upgradeLogInterval is how many values are upgraded between progress logs.
+const upgradeLogInterval = 100000
+
+// format tells the values of a database being upgraded apart: those of keys
+// from legacyFrom on are still RLE compressed without a header. The upgrade
+// moves legacyFrom on under formatMu, reads and writes of leveldb hold it for
+// reading, iterators keep the format they were created with.
+type format struct {
+	upgrading  bool
+	legacyFrom []byte
+}
+
+func (self format) legacy(key []byte) bool {
+	return self.upgrading && bytes.Compare(key, self.legacyFrom) >= 0
+}
+
+// encode encodes a value to be written under key with c, or as it was
+// before codecs if the upgrade has yet to reach key.
+func (self format) encode(c codec.Codec, key, value []byte) []byte {
+	if self.legacy(key) {
+		return codec.RLE{}.Encode(value)
+	}
+	return codec.Encode(c, value)
+}
+
+// decode decodes the value read from leveldb under key.
+func (self format) decode(key, data []byte) ([]byte, error) {
+	if self.legacy(key) {
+		return codec.RLE{}.Decode(data)
+	}
+	return codec.Decode(data)
+}
+
+// SetCodec sets the codec values are written with from now on. Values
+// written before keep theirs until they are written again.
+func (self *LDBDatabase) SetCodec(c codec.Codec) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	self.codec = c
+}
+
+// openFormat reads how far the upgrade of a database written before codecs
+// has got. A new database is marked as upgraded straight away.
+func (self *LDBDatabase) openFormat() error {
+	mark, err := self.db.Get(formatKey, nil)
+	switch {
+	case err == leveldb.ErrNotFound:
+		it := self.db.NewIterator(nil, nil)
+		empty := !it.Next()
+		it.Release()
+		if empty {
+			return self.db.Put(formatKey, []byte{1}, syncWrite)
+		}
+		self.format = format{upgrading: true}
+	case err != nil:
+		return err
+	case len(mark) == 1 && mark[0] == 1:
+	default:
+		self.format = format{upgrading: true, legacyFrom: append(mark[1:], 0)}
+	}
+	return nil
+}
+
+// upgradeFormat adds the RLE header to the values of a database written
+// before codecs, which were all RLE compressed. It runs in the background,
+// the values not upgraded yet are read as they were before codecs. Progress
+// is recorded with every batch, so an interrupted upgrade carries on where
+// it stopped when the database is opened again.
+func (self *LDBDatabase) upgradeFormat() {
+	defer close(self.upgradeDone)
+
+	if len(self.format.legacyFrom) > 0 {
+		klog.Infof("[upgradeFormat] resuming upgrade of %v at key %x", self.fn, self.format.legacyFrom)
+	} else {
+		klog.Infof("[upgradeFormat] upgrading %v to codec headers", self.fn)
+	}
+	begin := time.Now()
+	n := 0
+	for {
+		select {
+		case <-self.upgradeStop:
+			klog.Infof("[upgradeFormat] stopped upgrade of %v after %d values", self.fn, n)
+			return
+		default:
+		}
+		done, count, err := self.upgradeBatch()
+		if err != nil {
+			klog.Errorf("[upgradeFormat] upgrade of %v failed %v", self.fn, err)
+			return
+		}
+		if n += count; n%upgradeLogInterval < count {
+			klog.Infof("[upgradeFormat] upgraded %d values of %v in %v", n, self.fn, time.Since(begin))
+		}
+		if done {
+			klog.Infof("[upgradeFormat] added codec headers to %d values in %v in %v", n, self.fn, time.Since(begin))
+			return
+		}
+	}
+}
+
+// upgradeBatch adds the header to the next upgradeBatchSize values. done is
+// true once all values have it.
+func (self *LDBDatabase) upgradeBatch() (done bool, n int, err error) {
+	self.formatMu.Lock()
+	defer self.formatMu.Unlock()
+
+	it := self.db.NewIterator(prefixRange(nil, self.format.legacyFrom), nil)
+	defer it.Release()
+	batch := new(leveldb.Batch)
+	var last []byte
+	for n < upgradeBatchSize && it.Next() {
+		if bytes.Equal(it.Key(), formatKey) {
+			continue
+		}
+		last = common.CopyBytes(it.Key())
+		batch.Put(last, append([]byte{codec.IDRLE}, it.Value()...))
+		n++
+	}
+	if err := it.Error(); err != nil {
+		return false, 0, err
+	}
+	if done = n < upgradeBatchSize; done {
+		batch.Put(formatKey, []byte{1})
+	} else {
+		batch.Put(formatKey, append([]byte{0}, last...))
+	}
+	if err := self.db.Write(batch, syncWrite); err != nil {
+		return false, 0, err
+	}
+	if done {
+		self.format = format{}
+	} else {
+		self.format.legacyFrom = append(last, 0)
+	}
+	return done, n, nil
+}
@@ -0,0 +1,58 @@
+package kdb
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/MonteCarloClub/KBD/common"
+)
+
+// Engine names a storage engine.
+type Engine string
+
+const (
+	EngineLevelDB Engine = "leveldb"
+	EngineMemory  Engine = "memory"
+)
+
+var ErrUnknownEngine = errors.New("unknown storage engine")
+
+// Snapshot is a read-only view of a database at the time it was taken.
+type Snapshot interface {
+	Get(key []byte) ([]byte, error)
+	NewIterator(prefix, start []byte) common.Iterator
+	Release()
+}
+
+// NewDatabase opens the database in file with the given engine, leveldb if
+// engine is empty. The memory engine ignores file.
+func NewDatabase(engine Engine, file string) (common.Database, error) {
+	switch engine {
+	case "", EngineLevelDB:
+		db, err := NewLDBDatabase(file)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	case EngineMemory:
+		return NewMemDatabase()
+	}
+	return nil, ErrUnknownEngine
+}
+
+// scan collects up to limit entries of it before end, see LDBDatabase.Scan.
+func scan(it common.Iterator, end []byte, limit int) (keys, values [][]byte, next []byte, err error) {
+	defer it.Release()
+	for it.Next() {
+		if len(end) > 0 && bytes.Compare(it.Key(), end) >= 0 {
+			break
+		}
+		if len(keys) == limit {
+			next = common.CopyBytes(it.Key())
+			break
+		}
+		keys = append(keys, common.CopyBytes(it.Key()))
+		values = append(values, it.Value())
+	}
+	return keys, values, next, it.Error()
+}
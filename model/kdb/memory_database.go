@@ -1,15 +1,20 @@
 package kdb
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/MonteCarloClub/KBD/common"
+	"github.com/syndtr/goleveldb/leveldb"
 )
 
-/*
- * This is a test memory database. Do not use for any production it does not get persisted
- */
+// MemDatabase keeps its entries in memory only. It is safe for concurrent
+// use and behaves like LDBDatabase, so it can stand in for it wherever the
+// data does not need to outlive the process.
 type MemDatabase struct {
+	mu sync.RWMutex
 	db map[string][]byte
 }
 
@@ -20,7 +25,10 @@ func NewMemDatabase() (*MemDatabase, error) {
 }
 
 func (db *MemDatabase) Put(key []byte, value []byte) error {
-	db.db[string(key)] = value
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.db[string(key)] = common.CopyBytes(value)
 	return nil
 }
 
@@ -29,7 +37,13 @@ func (db *MemDatabase) Set(key []byte, value []byte) {
 }
 
 func (db *MemDatabase) Get(key []byte) ([]byte, error) {
-	return db.db[string(key)], nil
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	if dat, ok := db.db[string(key)]; ok {
+		return common.CopyBytes(dat), nil
+	}
+	return nil, leveldb.ErrNotFound
 }
 
 /*
@@ -41,12 +55,17 @@ func (db *MemDatabase) GetKeys() []*common.Key {
 */
 
 func (db *MemDatabase) Delete(key []byte) error {
-	delete(db.db, string(key))
+	db.mu.Lock()
+	defer db.mu.Unlock()
 
+	delete(db.db, string(key))
 	return nil
 }
 
 func (db *MemDatabase) Print() {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
 	for key, val := range db.db {
 		fmt.Printf("%x(%d): ", key, len(key))
 		node := common.NewValueFromBytes(val)
@@ -70,3 +89,152 @@ func (db *MemDatabase) LastKnownTD() []byte {
 func (db *MemDatabase) Flush() error {
 	return nil
 }
+
+// WriteBatch puts the key/value pairs and removes the deleted keys at once.
+func (db *MemDatabase) WriteBatch(keys, values, deleted [][]byte) error {
+	batch := db.NewBatch()
+	for i, key := range keys {
+		batch.Put(key, values[i])
+	}
+	for _, key := range deleted {
+		batch.Delete(key)
+	}
+	return batch.Write()
+}
+
+// Scan returns up to limit entries with the given prefix in the key range
+// [start, end), empty bounds being open. next is the key to resume from, nil
+// once the range is exhausted.
+func (db *MemDatabase) Scan(prefix, start, end []byte, limit int) (keys, values [][]byte, next []byte, err error) {
+	return scan(db.NewIterator(prefix, start), end, limit)
+}
+
+type memOp struct {
+	key, value []byte
+	del        bool
+}
+
+// memBatch buffers writes until Write applies them in order, all at once.
+type memBatch struct {
+	db  *MemDatabase
+	ops []memOp
+}
+
+func (db *MemDatabase) NewBatch() common.Batch {
+	return &memBatch{db: db}
+}
+
+func (b *memBatch) Put(key, value []byte) error {
+	b.ops = append(b.ops, memOp{key: common.CopyBytes(key), value: common.CopyBytes(value)})
+	return nil
+}
+
+func (b *memBatch) Delete(key []byte) error {
+	b.ops = append(b.ops, memOp{key: common.CopyBytes(key), del: true})
+	return nil
+}
+
+func (b *memBatch) Write() error {
+	b.db.mu.Lock()
+	defer b.db.mu.Unlock()
+
+	for _, op := range b.ops {
+		if op.del {
+			delete(b.db.db, string(op.key))
+		} else {
+			b.db.db[string(op.key)] = op.value
+		}
+	}
+	return nil
+}
+
+func (b *memBatch) Reset() {
+	b.ops = nil
+}
+
+// NewIterator iterates the keys with the given prefix as they are when it is
+// created, from start on if start is not empty.
+func (db *MemDatabase) NewIterator(prefix, start []byte) common.Iterator {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return newMemIterator(db.db, prefix, start)
+}
+
+// NewSnapshot returns a read-only view of the database as it is now.
+func (db *MemDatabase) NewSnapshot() (Snapshot, error) {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	// Stored values are never modified in place, so sharing them is safe.
+	snap := make(map[string][]byte, len(db.db))
+	for key, value := range db.db {
+		snap[key] = value
+	}
+	return memSnapshot{&MemDatabase{db: snap}}, nil
+}
+
+type memSnapshot struct {
+	db *MemDatabase
+}
+
+func (self memSnapshot) Get(key []byte) ([]byte, error) {
+	return self.db.Get(key)
+}
+
+func (self memSnapshot) NewIterator(prefix, start []byte) common.Iterator {
+	return self.db.NewIterator(prefix, start)
+}
+
+func (self memSnapshot) Release() {
+}
+
+// memIterator walks a sorted copy of the matching entries.
+type memIterator struct {
+	keys, values [][]byte
+	pos          int
+}
+
+func newMemIterator(db map[string][]byte, prefix, start []byte) *memIterator {
+	it := &memIterator{pos: -1}
+	for key, value := range db {
+		k := []byte(key)
+		if bytes.HasPrefix(k, prefix) && bytes.Compare(k, start) >= 0 {
+			it.keys = append(it.keys, k)
+			it.values = append(it.values, value)
+		}
+	}
+	sort.Sort(it)
+	return it
+}
+
+func (it *memIterator) Len() int           { return len(it.keys) }
+func (it *memIterator) Less(i, j int) bool { return bytes.Compare(it.keys[i], it.keys[j]) < 0 }
+func (it *memIterator) Swap(i, j int) {
+	it.keys[i], it.keys[j] = it.keys[j], it.keys[i]
+	it.values[i], it.values[j] = it.values[j], it.values[i]
+}
+
+func (it *memIterator) Next() bool {
+	if it.pos < len(it.keys) {
+		it.pos++
+	}
+	return it.pos < len(it.keys)
+}
+
+func (it *memIterator) Key() []byte {
+	if it.pos < 0 || it.pos >= len(it.keys) {
+		return nil
+	}
+	return it.keys[it.pos]
+}
+
+func (it *memIterator) Value() []byte {
+	if it.pos < 0 || it.pos >= len(it.keys) {
+		return nil
+	}
+	return common.CopyBytes(it.values[it.pos])
+}
+
+func (it *memIterator) Error() error { return nil }
+func (it *memIterator) Release()     {}
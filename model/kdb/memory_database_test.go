@@ -0,0 +1,36 @@
+package kdb
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestMemDatabaseConcurrent(t *testing.T) {
+	db, _ := NewMemDatabase()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				key := []byte(fmt.Sprintf("%d/%03d", i, j))
+				db.Put(key, key)
+				if res, err := db.Get(key); err != nil || string(res) != string(key) {
+					t.Errorf("expected %s, got %q %v", key, res, err)
+				}
+				it := db.NewIterator([]byte(fmt.Sprintf("%d/", i)), nil)
+				for it.Next() {
+				}
+				it.Release()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	keys, _, next, err := db.Scan([]byte("3/"), []byte("3/050"), nil, 10)
+	if err != nil || len(keys) != 10 || string(keys[0]) != "3/050" || string(next) != "3/060" {
+		t.Errorf("unexpected scan %q next %q %v", keys, next, err)
+	}
+}
@@ -0,0 +1,28 @@
+package kdb
+
+import (
+	"github.com/MonteCarloClub/KBD/metrics"
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+// dbMetrics report the depth of the write queue, how long flushes take and
+// how often reads are served from the cache.
+type dbMetrics struct {
+	keys  gometrics.Gauge
+	bytes gometrics.Gauge
+	flush gometrics.Timer
+	stall gometrics.Timer
+	hit   gometrics.Meter
+	miss  gometrics.Meter
+}
+
+func newDBMetrics(name string) dbMetrics {
+	return dbMetrics{
+		keys:  metrics.NewGauge("db/" + name + "/queue/keys"),
+		bytes: metrics.NewGauge("db/" + name + "/queue/bytes"),
+		flush: metrics.NewTimer("db/" + name + "/flush"),
+		stall: metrics.NewTimer("db/" + name + "/stall"),
+		hit:   metrics.NewMeter("db/" + name + "/cache/hit"),
+		miss:  metrics.NewMeter("db/" + name + "/cache/miss"),
+	}
+}
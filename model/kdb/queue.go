@@ -0,0 +1,132 @@
+package kdb
+
+import (
+	"time"
+)
+
+// FlushConfig bounds the write queue of an LDBDatabase. The queue is flushed
+// as soon as it holds FlushBytes of keys and values, FlushKeys keys or its
+// oldest write is FlushAge old. Writers block while it holds MaxQueueBytes,
+// until a flush has drained it. Zero fields take the default.
+type FlushConfig struct {
+	FlushBytes    int
+	FlushKeys     int
+	FlushAge      time.Duration
+	MaxQueueBytes int
+}
+
+var DefaultFlushConfig = FlushConfig{
+	FlushBytes:    4 << 20,
+	FlushKeys:     10000,
+	FlushAge:      time.Minute,
+	MaxQueueBytes: 16 << 20,
+}
+
+func (self FlushConfig) withDefaults() FlushConfig {
+	if self.FlushBytes <= 0 {
+		self.FlushBytes = DefaultFlushConfig.FlushBytes
+	}
+	if self.FlushKeys <= 0 {
+		self.FlushKeys = DefaultFlushConfig.FlushKeys
+	}
+	if self.FlushAge <= 0 {
+		self.FlushAge = DefaultFlushConfig.FlushAge
+	}
+	if self.MaxQueueBytes <= 0 {
+		self.MaxQueueBytes = DefaultFlushConfig.MaxQueueBytes
+	}
+	return self
+}
+
+// SetFlushConfig sets when the write queue is flushed from now on.
+func (self *LDBDatabase) SetFlushConfig(config FlushConfig) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	self.flushConfig = config.withDefaults()
+	self.triggerFlush()
+	self.drained.Broadcast()
+}
+
+func (self *LDBDatabase) makeQueue() {
+	self.queue = make(map[string][]byte)
+	self.queueBytes = 0
+	self.metrics.keys.Update(0)
+	self.metrics.bytes.Update(0)
+	self.drained.Broadcast()
+}
+
+// enqueue adds a write to the queue and wakes the flusher once the queue is
+// due. Callers hold mu.
+func (self *LDBDatabase) enqueue(key, value []byte) {
+	if len(self.queue) == 0 {
+		self.queuedAt = time.Now()
+	}
+	if old, ok := self.queue[string(key)]; ok {
+		self.queueBytes -= len(key) + len(old)
+	}
+	self.queue[string(key)] = value
+	self.queueBytes += len(key) + len(value)
+	self.updateQueueMetrics()
+	if self.queueBytes >= self.flushConfig.FlushBytes || len(self.queue) >= self.flushConfig.FlushKeys {
+		self.triggerFlush()
+	}
+}
+
+// dequeue drops a queued write. Callers hold mu.
+func (self *LDBDatabase) dequeue(key []byte) {
+	if old, ok := self.queue[string(key)]; ok {
+		delete(self.queue, string(key))
+		self.queueBytes -= len(key) + len(old)
+		self.updateQueueMetrics()
+	}
+}
+
+func (self *LDBDatabase) updateQueueMetrics() {
+	self.metrics.keys.Update(int64(len(self.queue)))
+	self.metrics.bytes.Update(int64(self.queueBytes))
+}
+
+func (self *LDBDatabase) triggerFlush() {
+	select {
+	case self.flushc <- struct{}{}:
+	default:
+	}
+}
+
+// waitQueue blocks while the queue has no room for size more bytes. Callers
+// hold mu, which is released while waiting.
+func (self *LDBDatabase) waitQueue(size int) {
+	full := func() bool {
+		return self.queueBytes > 0 && self.queueBytes+size > self.flushConfig.MaxQueueBytes && !self.closed
+	}
+	if !full() {
+		return
+	}
+	start := time.Now()
+	self.waiting++
+	for full() {
+		self.triggerFlush()
+		self.drained.Wait()
+	}
+	self.waiting--
+	self.metrics.stall.UpdateSince(start)
+}
+
+// flushDue reports whether the queue should be flushed now, and otherwise how
+// long until its oldest write reaches the flush age.
+func (self *LDBDatabase) flushDue() (bool, time.Duration) {
+	self.mu.RLock()
+	defer self.mu.RUnlock()
+
+	if len(self.queue) == 0 {
+		return false, self.flushConfig.FlushAge
+	}
+	if self.waiting > 0 || self.queueBytes >= self.flushConfig.FlushBytes || len(self.queue) >= self.flushConfig.FlushKeys {
+		return true, 0
+	}
+	if age := time.Since(self.queuedAt); age < self.flushConfig.FlushAge {
+		return false, self.flushConfig.FlushAge - age
+	}
+	return true, 0
+}
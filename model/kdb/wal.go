@@ -0,0 +1,158 @@
+package kdb
+
+import (
+	"bufio"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+)
+
+const (
+	walPut byte = iota
+	walDelete
+)
+
+// walRecord is a single logged write. Records are stored as
+// op | key length | value length | key | value | crc32 of all before.
+type walRecord struct {
+	op    byte
+	key   []byte
+	value []byte
+}
+
+// wal is an append-only log of writes that have not reached leveldb yet.
+// While a flush writes the queue to leveldb, the writes it holds are kept in
+// the rotated out log and later ones in a new one.
+type wal struct {
+	file string
+	f    *os.File
+	old  *os.File
+}
+
+func openWAL(file string) (*wal, error) {
+	f, err := os.OpenFile(file, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &wal{file: file, f: f}, nil
+}
+
+// oldWALFile is where the log being flushed is kept.
+func oldWALFile(file string) string {
+	return file + ".old"
+}
+
+// append writes the records and syncs them to disk. Nothing is logged if
+// it fails.
+func (self *wal) append(records ...walRecord) error {
+	var buf []byte
+	for _, r := range records {
+		start := len(buf)
+		header := make([]byte, 9)
+		header[0] = r.op
+		binary.BigEndian.PutUint32(header[1:5], uint32(len(r.key)))
+		binary.BigEndian.PutUint32(header[5:9], uint32(len(r.value)))
+		buf = append(buf, header...)
+		buf = append(buf, r.key...)
+		buf = append(buf, r.value...)
+		sum := make([]byte, 4)
+		binary.BigEndian.PutUint32(sum, crc32.ChecksumIEEE(buf[start:]))
+		buf = append(buf, sum...)
+	}
+	info, err := self.f.Stat()
+	if err != nil {
+		return err
+	}
+	if _, err = self.f.Write(buf); err == nil {
+		err = self.f.Sync()
+	}
+	if err != nil {
+		// Cut off what was written, a torn record would end the log on
+		// replay and a complete one be replayed although it failed.
+		if terr := self.f.Truncate(info.Size()); terr != nil {
+			return terr
+		}
+		return err
+	}
+	return nil
+}
+
+// replay calls fn for every record in the log. A torn or corrupt record ends
+// the log, it can only be the last one written before a crash.
+func (self *wal) replay(fn func(walRecord)) error {
+	if _, err := self.f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	r := bufio.NewReader(self.f)
+	for {
+		header := make([]byte, 9)
+		if _, err := io.ReadFull(r, header); err != nil {
+			return nil
+		}
+		body := make([]byte, binary.BigEndian.Uint32(header[1:5])+binary.BigEndian.Uint32(header[5:9])+4)
+		if _, err := io.ReadFull(r, body); err != nil {
+			return nil
+		}
+		sum := crc32.NewIEEE()
+		sum.Write(header)
+		sum.Write(body[:len(body)-4])
+		if sum.Sum32() != binary.BigEndian.Uint32(body[len(body)-4:]) {
+			return nil
+		}
+		klen := binary.BigEndian.Uint32(header[1:5])
+		fn(walRecord{op: header[0], key: body[:klen], value: body[klen : len(body)-4]})
+	}
+}
+
+// empty reports whether nothing has been logged since the last rotate.
+func (self *wal) empty() bool {
+	info, err := self.f.Stat()
+	return err == nil && info.Size() == 0
+}
+
+// rotate moves the records logged so far aside, before the writes they hold
+// are flushed, and starts a new log for later writes.
+func (self *wal) rotate() error {
+	if err := os.Rename(self.file, oldWALFile(self.file)); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(self.file, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		os.Rename(oldWALFile(self.file), self.file)
+		return err
+	}
+	self.old, self.f = self.f, f
+	return nil
+}
+
+// drop removes the rotated out log once its writes are safely in leveldb.
+func (self *wal) drop() error {
+	self.old.Close()
+	self.old = nil
+	return os.Remove(oldWALFile(self.file))
+}
+
+// restore undoes rotate when the flush failed, appending the records logged
+// since to the rotated out log and making it the log again.
+func (self *wal) restore() error {
+	var records []walRecord
+	if err := self.replay(func(r walRecord) { records = append(records, r) }); err != nil {
+		return err
+	}
+	self.f, self.old = self.old, self.f
+	if err := self.append(records...); err != nil {
+		self.f, self.old = self.old, self.f
+		return err
+	}
+	self.old.Close()
+	self.old = nil
+	return os.Rename(oldWALFile(self.file), self.file)
+}
+
+func (self *wal) close() error {
+	if self.old != nil {
+		self.old.Close()
+	}
+	return self.f.Close()
+}
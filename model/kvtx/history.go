@@ -0,0 +1,182 @@
+package kvtx
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// CompactInterval is how often revisions past the retention are removed.
+var CompactInterval = time.Hour
+
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// historyPrefix prefixes the keys under which past revisions are kept, as
+// historyPrefix | key | version.
+var historyPrefix = []byte("history-")
+
+// Revision is the state of a key after one of its writes.
+type Revision struct {
+	Version uint64
+	Time    time.Time
+	Deleted bool
+	Data    []byte
+}
+
+func (self Revision) encode() []byte {
+	data := make([]byte, 9, 9+len(self.Data))
+	binary.BigEndian.PutUint64(data, uint64(self.Time.UnixNano()))
+	if self.Deleted {
+		data[8] = 1
+	}
+	return append(data, self.Data...)
+}
+
+func decodeRevision(version uint64, data []byte) (Revision, bool) {
+	if len(data) < 9 {
+		return Revision{}, false
+	}
+	return Revision{
+		Version: version,
+		Time:    time.Unix(0, int64(binary.BigEndian.Uint64(data))),
+		Deleted: data[8] == 1,
+		Data:    data[9:],
+	}, true
+}
+
+func historyKey(key []byte, version uint64) []byte {
+	hkey := make([]byte, len(historyPrefix)+len(key)+8)
+	copy(hkey, historyPrefix)
+	copy(hkey[len(historyPrefix):], key)
+	binary.BigEndian.PutUint64(hkey[len(hkey)-8:], version)
+	return hkey
+}
+
+// revision returns the given revision of key, false if it was never written
+// or has been compacted.
+func (self *Manager) revision(key []byte, version uint64) (Revision, bool) {
+	data, err := self.store.Get(historyKey(key, version))
+	if err != nil {
+		return Revision{}, false
+	}
+	return decodeRevision(version, data)
+}
+
+// GetAt returns the given revision of key.
+func (self *Manager) GetAt(key []byte, version uint64) (Revision, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	rev, ok := self.revision(key, version)
+	if !ok {
+		return Revision{}, ErrRevisionNotFound
+	}
+	return rev, nil
+}
+
+// GetAtTime returns the revision of key that was current at t.
+func (self *Manager) GetAtTime(key []byte, t time.Time) (Revision, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	// Revision times grow with their version and compaction only removes
+	// the oldest ones, so search for the first revision written after t.
+	lo, hi := uint64(1), self.version(key)+1
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if rev, ok := self.revision(key, mid); ok && rev.Time.After(t) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	rev, ok := self.revision(key, lo-1)
+	if lo == 1 || !ok {
+		return Revision{}, ErrRevisionNotFound
+	}
+	return rev, nil
+}
+
+// History returns up to limit revisions of key, newest first.
+func (self *Manager) History(key []byte, limit int) []Revision {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	var revs []Revision
+	for version := self.version(key); version > 0 && len(revs) < limit; version-- {
+		rev, ok := self.revision(key, version)
+		if !ok {
+			break
+		}
+		revs = append(revs, rev)
+	}
+	return revs
+}
+
+// SetRetention keeps revisions for at least d and starts compacting older
+// ones every CompactInterval. Revisions are kept forever by default.
+func (self *Manager) SetRetention(d time.Duration) {
+	self.mu.Lock()
+	start := self.retention == 0 && d > 0
+	self.retention = d
+	self.mu.Unlock()
+
+	if start {
+		go self.compactLoop()
+	}
+}
+
+func (self *Manager) compactLoop() {
+	ticker := time.NewTicker(CompactInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := self.Compact(); err != nil {
+			klog.Errorf("[Compact] compact history failed %v", err)
+		}
+	}
+}
+
+// Compact removes revisions that were replaced before the retention period.
+// The revision that was current when the period began is kept, so that
+// reads at any time within the period can still be answered.
+func (self *Manager) Compact() error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if self.retention == 0 {
+		return nil
+	}
+	cutoff := self.now().Add(-self.retention)
+
+	var start []byte
+	for {
+		keys, values, next, err := self.store.Scan(historyPrefix, start, nil, 1000)
+		if err != nil {
+			return err
+		}
+		var deleted [][]byte
+		for i, hkey := range keys {
+			key := hkey[len(historyPrefix) : len(hkey)-8]
+			version := binary.BigEndian.Uint64(hkey[len(hkey)-8:])
+			rev, ok := decodeRevision(version, values[i])
+			if !ok || !rev.Time.Before(cutoff) {
+				continue
+			}
+			if newer, ok := self.revision(key, version+1); ok && !newer.Time.After(cutoff) {
+				deleted = append(deleted, hkey)
+			}
+		}
+		if len(deleted) > 0 {
+			if err := self.store.WriteBatch(nil, nil, deleted); err != nil {
+				return err
+			}
+			klog.Infof("[Compact] removed %d revisions", len(deleted))
+		}
+		if next == nil {
+			return nil
+		}
+		start = next
+	}
+}
@@ -0,0 +1,469 @@
+package kvtx
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+var (
+	ErrInvalidIndex  = errors.New("invalid index")
+	ErrIndexNotFound = errors.New("index not found")
+	ErrIndexExists   = errors.New("index already exists")
+	ErrInvalidQuery  = errors.New("invalid index query")
+)
+
+var (
+	// indexPrefix prefixes the keys index definitions are kept under, as
+	// indexPrefix | namespace | 0 | name.
+	indexPrefix = []byte("i")
+	// entryPrefix prefixes the keys of index entries, as
+	// entryPrefix | namespace | 0 | name | 0 | value | key. Values are
+	// encoded so that they sort in their natural order and delimit
+	// themselves.
+	entryPrefix = []byte("x")
+)
+
+// Index is a secondary index over the JSON values of the keys of a namespace
+// starting with Prefix. Keys are indexed by the scalar found at Path, a dot
+// separated list of object fields. Keys whose value has no scalar there are
+// left out.
+type Index struct {
+	Namespace string
+	Name      string
+	Prefix    string
+	Path      string
+}
+
+func indexKey(ns, name string) []byte {
+	key := append(append([]byte{}, indexPrefix...), ns...)
+	return append(append(key, 0), name...)
+}
+
+func indexEntryPrefix(ns, name string) []byte {
+	key := append(append([]byte{}, entryPrefix...), ns...)
+	key = append(append(key, 0), name...)
+	return append(key, 0)
+}
+
+// splitDataKey returns the namespace and key of a store key made by DataKey.
+func splitDataKey(dkey []byte) (string, []byte, bool) {
+	if !bytes.HasPrefix(dkey, dataPrefix) {
+		return "", nil, false
+	}
+	rest := dkey[len(dataPrefix):]
+	i := bytes.IndexByte(rest, 0)
+	if i < 0 {
+		return "", nil, false
+	}
+	return string(rest[:i]), rest[i+1:], true
+}
+
+// encodeIndexValue encodes a JSON scalar as a type byte followed by bytes
+// that sort like the value. Strings escape zero bytes as 0 0xff and end with
+// 0 1.
+func encodeIndexValue(v interface{}) ([]byte, bool) {
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return []byte{'b', 1}, true
+		}
+		return []byte{'b', 0}, true
+	case float64:
+		if v == 0 {
+			v = 0 // no negative zero
+		}
+		bits := math.Float64bits(v)
+		if bits>>63 == 0 {
+			bits |= 1 << 63
+		} else {
+			bits = ^bits
+		}
+		enc := make([]byte, 9)
+		enc[0] = 'n'
+		binary.BigEndian.PutUint64(enc[1:], bits)
+		return enc, true
+	case string:
+		enc := make([]byte, 0, len(v)+3)
+		enc = append(enc, 's')
+		for i := 0; i < len(v); i++ {
+			if v[i] == 0 {
+				enc = append(enc, 0, 0xff)
+			} else {
+				enc = append(enc, v[i])
+			}
+		}
+		return append(enc, 0, 1), true
+	}
+	return nil, false
+}
+
+// indexValueLen returns the length of the encoded value enc starts with.
+func indexValueLen(enc []byte) int {
+	if len(enc) == 0 {
+		return -1
+	}
+	switch enc[0] {
+	case 'b':
+		return 2
+	case 'n':
+		return 9
+	case 's':
+		for i := 1; i+1 < len(enc); i++ {
+			if enc[i] != 0 {
+				continue
+			}
+			if enc[i+1] == 1 {
+				return i + 2
+			}
+			i++
+		}
+	}
+	return -1
+}
+
+// lookup returns the encoded scalar at the path of the index in data.
+func (self *Index) lookup(data []byte) ([]byte, bool) {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, false
+	}
+	for _, field := range strings.Split(self.Path, ".") {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = obj[field]; !ok {
+			return nil, false
+		}
+	}
+	return encodeIndexValue(v)
+}
+
+// entry returns the index entry of key holding data, nil if it has none.
+func (self *Index) entry(key, data []byte) []byte {
+	if !strings.HasPrefix(string(key), self.Prefix) {
+		return nil
+	}
+	enc, ok := self.lookup(data)
+	if !ok {
+		return nil
+	}
+	return append(append(indexEntryPrefix(self.Namespace, self.Name), enc...), key...)
+}
+
+// loadIndexes reads the index definitions the first time they are needed.
+func (self *Manager) loadIndexes() error {
+	if self.indexes != nil {
+		return nil
+	}
+	indexes := make(map[string][]*Index)
+	var start []byte
+	for {
+		keys, values, next, err := self.store.Scan(indexPrefix, start, nil, 1000)
+		if err != nil {
+			return err
+		}
+		for i := range keys {
+			idx := new(Index)
+			if err := json.Unmarshal(values[i], idx); err != nil {
+				return err
+			}
+			indexes[idx.Namespace] = append(indexes[idx.Namespace], idx)
+		}
+		if next == nil {
+			break
+		}
+		start = next
+	}
+	self.indexes = indexes
+	return nil
+}
+
+func (self *Manager) index(ns, name string) (*Index, error) {
+	if err := self.loadIndexes(); err != nil {
+		return nil, err
+	}
+	for _, idx := range self.indexes[ns] {
+		if idx.Name == name {
+			return idx, nil
+		}
+	}
+	return nil, ErrIndexNotFound
+}
+
+// indexWrites returns the index entries to add and to remove when keys are
+// set to values and deleted are removed.
+func (self *Manager) indexWrites(keys, values, deleted [][]byte) (puts, removes [][]byte, err error) {
+	if err := self.loadIndexes(); err != nil {
+		return nil, nil, err
+	}
+	if len(self.indexes) == 0 {
+		return nil, nil, nil
+	}
+
+	// A batch may write a key more than once, only its value before the
+	// batch and its last one count.
+	type change struct {
+		ns      string
+		key     []byte
+		old, to value
+	}
+	var order []string
+	changes := make(map[string]*change)
+	update := func(dkey, data []byte, exists bool) error {
+		ns, key, ok := splitDataKey(dkey)
+		if !ok || len(self.indexes[ns]) == 0 {
+			return nil
+		}
+		c := changes[string(dkey)]
+		if c == nil {
+			old, err := self.get(dkey)
+			if err != nil {
+				return err
+			}
+			c = &change{ns: ns, key: key, old: old}
+			changes[string(dkey)] = c
+			order = append(order, string(dkey))
+		}
+		c.to = value{data, exists}
+		return nil
+	}
+	for i, key := range keys {
+		if err := update(key, values[i], true); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, key := range deleted {
+		if err := update(key, nil, false); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, dkey := range order {
+		c := changes[dkey]
+		for _, idx := range self.indexes[c.ns] {
+			var before, after []byte
+			if c.old.exists {
+				before = idx.entry(c.key, c.old.data)
+			}
+			if c.to.exists {
+				after = idx.entry(c.key, c.to.data)
+			}
+			if bytes.Equal(before, after) {
+				continue
+			}
+			if before != nil {
+				removes = append(removes, before)
+			}
+			if after != nil {
+				puts = append(puts, after)
+			}
+		}
+	}
+	return puts, removes, nil
+}
+
+// CreateIndex declares an index and builds it from the keys already in its
+// namespace.
+func (self *Manager) CreateIndex(idx Index) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if !namespaceName.MatchString(idx.Name) || idx.Path == "" {
+		return ErrInvalidIndex
+	}
+	ok, err := self.hasNamespace(idx.Namespace)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNamespaceNotFound
+	}
+	if _, err := self.index(idx.Namespace, idx.Name); err != ErrIndexNotFound {
+		if err == nil {
+			return ErrIndexExists
+		}
+		return err
+	}
+
+	var start []byte
+	built := 0
+	for {
+		keys, values, next, err := self.store.Scan(DataKey(idx.Namespace, []byte(idx.Prefix)), start, nil, 1000)
+		if err != nil {
+			return err
+		}
+		var entries, marks [][]byte
+		for i, dkey := range keys {
+			_, key, _ := splitDataKey(dkey)
+			if entry := idx.entry(key, values[i]); entry != nil {
+				entries = append(entries, entry)
+				marks = append(marks, []byte{})
+			}
+		}
+		if len(entries) > 0 {
+			if err := self.store.WriteBatch(entries, marks, nil); err != nil {
+				return err
+			}
+			built += len(entries)
+		}
+		if next == nil {
+			break
+		}
+		start = next
+	}
+
+	data, _ := json.Marshal(idx)
+	if err := self.store.Put(indexKey(idx.Namespace, idx.Name), data); err != nil {
+		return err
+	}
+	self.indexes[idx.Namespace] = append(self.indexes[idx.Namespace], &idx)
+	klog.Infof("[CreateIndex] built %s/%s with %d entries", idx.Namespace, idx.Name, built)
+	return nil
+}
+
+func (self *Manager) DropIndex(ns, name string) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	return self.dropIndex(ns, name)
+}
+
+func (self *Manager) dropIndex(ns, name string) error {
+	if _, err := self.index(ns, name); err != nil {
+		return err
+	}
+	if err := self.store.Delete(indexKey(ns, name)); err != nil {
+		return err
+	}
+	var kept []*Index
+	for _, idx := range self.indexes[ns] {
+		if idx.Name != name {
+			kept = append(kept, idx)
+		}
+	}
+	self.indexes[ns] = kept
+
+	prefix := indexEntryPrefix(ns, name)
+	for {
+		keys, _, _, err := self.store.Scan(prefix, nil, nil, 1000)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return nil
+		}
+		if err := self.store.WriteBatch(nil, nil, keys); err != nil {
+			return err
+		}
+	}
+}
+
+// Indexes returns the indexes of ns.
+func (self *Manager) Indexes(ns string) ([]Index, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if err := self.loadIndexes(); err != nil {
+		return nil, err
+	}
+	indexes := make([]Index, 0, len(self.indexes[ns]))
+	for _, idx := range self.indexes[ns] {
+		indexes = append(indexes, *idx)
+	}
+	return indexes, nil
+}
+
+// IndexQuery selects the keys of an index whose value equals Equals or, if
+// that is nil, lies in [From, To). A missing bound leaves the range open up
+// to the values of the same type. Cursor resumes a previous query.
+type IndexQuery struct {
+	Equals   interface{}
+	From, To interface{}
+	Cursor   []byte
+	Limit    int
+}
+
+// QueryIndex returns a page of the keys and values selected by q, ordered by
+// value and then key.
+func (self *Manager) QueryIndex(ns, name string, q IndexQuery) (keys, values [][]byte, next []byte, err error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if _, err := self.index(ns, name); err != nil {
+		return nil, nil, nil, err
+	}
+	base := indexEntryPrefix(ns, name)
+	prefix, start, end := base, base, []byte(nil)
+	bound := func(v interface{}) ([]byte, error) {
+		enc, ok := encodeIndexValue(v)
+		if !ok {
+			return nil, ErrInvalidQuery
+		}
+		return append(append([]byte{}, base...), enc...), nil
+	}
+	switch {
+	case q.Equals != nil:
+		if q.From != nil || q.To != nil {
+			return nil, nil, nil, ErrInvalidQuery
+		}
+		if prefix, err = bound(q.Equals); err != nil {
+			return nil, nil, nil, err
+		}
+		start = prefix
+	case q.From != nil || q.To != nil:
+		if q.From != nil {
+			if start, err = bound(q.From); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+		if q.To != nil {
+			if end, err = bound(q.To); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+		// Open ends stop at the values of the other types.
+		typ := len(base)
+		if q.From == nil {
+			start = append(append([]byte{}, base...), end[typ])
+		} else if q.To == nil {
+			end = append(append([]byte{}, base...), start[typ]+1)
+		} else if start[typ] != end[typ] {
+			return nil, nil, nil, ErrInvalidQuery
+		}
+	}
+	if len(q.Cursor) > 0 {
+		if cursor := append(append([]byte{}, base...), q.Cursor...); bytes.Compare(cursor, start) > 0 {
+			start = cursor
+		}
+	}
+
+	entries, _, last, err := self.store.Scan(prefix, start, end, q.Limit)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, entry := range entries {
+		enc := entry[len(base):]
+		n := indexValueLen(enc)
+		if n < 0 {
+			continue
+		}
+		key := enc[n:]
+		data, err := self.store.Get(DataKey(ns, key))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		keys = append(keys, key)
+		values = append(values, data)
+	}
+	if last != nil {
+		next = last[len(base):]
+	}
+	return keys, values, next, nil
+}
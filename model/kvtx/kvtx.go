@@ -0,0 +1,524 @@
+package kvtx
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/model/changelog"
+	"github.com/MonteCarloClub/KBD/model/trie"
+	"github.com/MonteCarloClub/KBD/rlp"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// TxTimeout is how long a transaction may stay open. Expired transactions
+// are dropped the next time one is started.
+var TxTimeout = 5 * time.Minute
+
+var (
+	ErrTxNotFound = errors.New("transaction not found")
+	ErrTxConflict = errors.New("transaction conflict")
+)
+
+// versionPrefix prefixes the key under which the version of a key is kept.
+var versionPrefix = []byte("version-")
+
+// VersionErr is returned by CompareAndSwap when the stored version of a key
+// is not the expected one.
+type VersionErr struct {
+	Key           []byte
+	Expected, Has uint64
+}
+
+func (err *VersionErr) Error() string {
+	return fmt.Sprintf("version conflict on %q: expected %d, has %d", err.Key, err.Expected, err.Has)
+}
+
+func IsVersionErr(err error) bool {
+	_, ok := err.(*VersionErr)
+
+	return ok
+}
+
+// Store is the key/value store transactions are applied to.
+type Store interface {
+	Get(key []byte) ([]byte, error)
+	Put(key []byte, value []byte) error
+	Delete(key []byte) error
+	WriteBatch(keys, values, deleted [][]byte) error
+	Scan(prefix, start, end []byte, limit int) (keys, values [][]byte, next []byte, err error)
+}
+
+// value is a possibly absent value.
+type value struct {
+	data   []byte
+	exists bool
+}
+
+type tx struct {
+	start uint64
+	begun time.Time
+
+	reads  map[string]struct{}
+	writes map[string]value
+	// snapshot holds the values keys had when the transaction started, for
+	// keys that were written by others since.
+	snapshot map[string]value
+}
+
+// Manager runs optimistic transactions over a Store. Transactions read the
+// snapshot taken when they started and fail to commit if any key they read
+// or wrote was written by someone else in the meantime. Writes that go
+// around the Manager are not detected.
+type Manager struct {
+	mu    sync.Mutex
+	store Store
+
+	seq     uint64
+	nextID  uint64
+	written map[string]uint64
+	txs     map[uint64]*tx
+
+	now       func() time.Time
+	retention time.Duration
+
+	// indexes holds the indexes of every namespace, nil until loaded.
+	indexes map[string][]*Index
+
+	// changes, if set, gets every write to a namespace.
+	changes *changelog.Log
+
+	// kv mirrors the keyspace, root is its last recorded root. Every write
+	// is journaled under the next write sequence number, rootSeq is the one
+	// of the last write included in root.
+	kv       *trie.SecureTrie
+	nodes    *nodeStore
+	roots    common.Database
+	root     common.Hash
+	writeSeq uint64
+	rootSeq  uint64
+}
+
+func NewManager(store Store) *Manager {
+	return &Manager{
+		store:   store,
+		nodes:   newNodeStore(store),
+		written: make(map[string]uint64),
+		txs:     make(map[uint64]*tx),
+		now:     time.Now,
+	}
+}
+
+// Begin starts a transaction and returns its id.
+func (self *Manager) Begin() uint64 {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	now := time.Now()
+	for id, tx := range self.txs {
+		if now.Sub(tx.begun) > TxTimeout {
+			delete(self.txs, id)
+		}
+	}
+
+	self.nextID++
+	self.txs[self.nextID] = &tx{
+		start:    self.seq,
+		begun:    now,
+		reads:    make(map[string]struct{}),
+		writes:   make(map[string]value),
+		snapshot: make(map[string]value),
+	}
+	self.prune()
+	return self.nextID
+}
+
+// TxGet reads key as seen by the transaction.
+func (self *Manager) TxGet(id uint64, key []byte) ([]byte, bool, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	tx := self.txs[id]
+	if tx == nil {
+		return nil, false, ErrTxNotFound
+	}
+	if v, ok := tx.writes[string(key)]; ok {
+		return v.data, v.exists, nil
+	}
+	tx.reads[string(key)] = struct{}{}
+	if v, ok := tx.snapshot[string(key)]; ok {
+		return v.data, v.exists, nil
+	}
+	v, err := self.get(key)
+	return v.data, v.exists, err
+}
+
+func (self *Manager) TxPut(id uint64, key, data []byte) error {
+	return self.txWrite(id, key, value{data, true})
+}
+
+func (self *Manager) TxDelete(id uint64, key []byte) error {
+	return self.txWrite(id, key, value{})
+}
+
+func (self *Manager) txWrite(id uint64, key []byte, v value) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	tx := self.txs[id]
+	if tx == nil {
+		return ErrTxNotFound
+	}
+	tx.writes[string(key)] = v
+	return nil
+}
+
+// Commit applies the writes of the transaction as a single batch. The
+// transaction is gone afterwards, whether it succeeded or not.
+func (self *Manager) Commit(id uint64) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	tx := self.txs[id]
+	if tx == nil {
+		return ErrTxNotFound
+	}
+	delete(self.txs, id)
+	defer self.prune()
+
+	for key := range tx.reads {
+		if self.written[key] > tx.start {
+			return ErrTxConflict
+		}
+	}
+	for key := range tx.writes {
+		if self.written[key] > tx.start {
+			return ErrTxConflict
+		}
+	}
+	if len(tx.writes) == 0 {
+		return nil
+	}
+
+	var keys, values, deleted [][]byte
+	for key, v := range tx.writes {
+		if v.exists {
+			keys = append(keys, []byte(key))
+			values = append(values, v.data)
+		} else {
+			deleted = append(deleted, []byte(key))
+		}
+	}
+	_, err := self.write(keys, values, deleted)
+	return err
+}
+
+func (self *Manager) Rollback(id uint64) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if self.txs[id] == nil {
+		return ErrTxNotFound
+	}
+	delete(self.txs, id)
+	self.prune()
+	return nil
+}
+
+// Get reads key outside of any transaction together with its version.
+func (self *Manager) Get(key []byte) ([]byte, uint64, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	data, err := self.store.Get(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	return data, self.version(key), nil
+}
+
+// Put writes key outside of any transaction and returns its new version.
+func (self *Manager) Put(key, data []byte) (uint64, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	versions, err := self.write([][]byte{key}, [][]byte{data}, nil)
+	if err != nil {
+		return 0, err
+	}
+	return versions[0], nil
+}
+
+// CompareAndSwap writes key if its version is still expected, zero meaning
+// the key has never been written. It returns the new version, or a
+// *VersionErr if the version has moved on.
+func (self *Manager) CompareAndSwap(key []byte, expected uint64, data []byte) (uint64, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if has := self.version(key); has != expected {
+		return 0, &VersionErr{Key: key, Expected: expected, Has: has}
+	}
+	versions, err := self.write([][]byte{key}, [][]byte{data}, nil)
+	if err != nil {
+		return 0, err
+	}
+	return versions[0], nil
+}
+
+// Delete removes key outside of any transaction. Its version is kept and
+// bumped.
+func (self *Manager) Delete(key []byte) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	_, err := self.write(nil, nil, [][]byte{key})
+	return err
+}
+
+// WriteBatch applies puts and deletes outside of any transaction.
+func (self *Manager) WriteBatch(keys, values, deleted [][]byte) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	_, err := self.write(keys, values, deleted)
+	return err
+}
+
+// Import writes keys outside of any transaction as data copied in from
+// elsewhere: their versions and index entries are written, but neither
+// revisions nor change log entries are.
+func (self *Manager) Import(keys, values [][]byte) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	_, err := self.apply(keys, values, nil, false)
+	return err
+}
+
+// write puts keys and removes deleted in a single batch, together with the
+// versions, revisions and index entries, and bumps the version of every key
+// it touches. The previous values are
+// kept for open transactions that have not seen them yet. It returns the
+// new versions of keys followed by those of deleted, after only the last
+// write of a key repeated in the batch has been kept.
+func (self *Manager) write(keys, values, deleted [][]byte) ([]uint64, error) {
+	return self.apply(keys, values, deleted, true)
+}
+
+// apply is write, recording the revisions and changes only if record is set.
+func (self *Manager) apply(keys, values, deleted [][]byte, record bool) ([]uint64, error) {
+	keys, values, deleted = lastWrites(keys, values, deleted)
+	touched := make([][]byte, 0, len(keys)+len(deleted))
+	touched = append(append(touched, keys...), deleted...)
+	if len(self.txs) > 0 {
+		for _, key := range touched {
+			self.preserve(key)
+		}
+	}
+
+	// Every write bumps the version of the key and records the revision.
+	now := self.now()
+	versions := make([]uint64, len(touched))
+	var metaKeys, metaValues [][]byte
+	for i, key := range touched {
+		versions[i] = self.version(key) + 1
+		data := make([]byte, 8)
+		binary.BigEndian.PutUint64(data, versions[i])
+		metaKeys = append(metaKeys, versionKey(key))
+		metaValues = append(metaValues, data)
+		if record {
+			rev := Revision{Version: versions[i], Time: now, Deleted: i >= len(keys)}
+			if !rev.Deleted {
+				rev.Data = values[i]
+			}
+			metaKeys = append(metaKeys, historyKey(key, versions[i]))
+			metaValues = append(metaValues, rev.encode())
+		}
+	}
+	entries, removed, err := self.indexWrites(keys, values, deleted)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		metaKeys = append(metaKeys, entry)
+		metaValues = append(metaValues, []byte{})
+	}
+
+	if self.kv != nil {
+		journal, err := rlp.EncodeToBytes(touched)
+		if err != nil {
+			return nil, err
+		}
+		metaKeys = append(metaKeys, journalKey(self.writeSeq+1))
+		metaValues = append(metaValues, journal)
+	}
+
+	var pending *changelog.Pending
+	if record {
+		if pending, err = self.logChanges(keys, values, deleted, versions, now); err != nil {
+			return nil, err
+		}
+	}
+	if pending != nil {
+		metaKeys = append(metaKeys, pending.Keys...)
+		metaValues = append(metaValues, pending.Values...)
+	}
+
+	puts := append(append(make([][]byte, 0, len(keys)+len(metaKeys)), keys...), metaKeys...)
+	data := append(append(make([][]byte, 0, len(values)+len(metaValues)), values...), metaValues...)
+	dels := append(append(make([][]byte, 0, len(deleted)+len(removed)), deleted...), removed...)
+	if err := self.store.WriteBatch(puts, data, dels); err != nil {
+		if pending != nil {
+			pending.Abort()
+		}
+		return nil, err
+	}
+	if pending != nil {
+		pending.Commit()
+	}
+	if self.kv != nil {
+		self.writeSeq++
+	}
+
+	for i, key := range keys {
+		self.mirror(key, values[i], true)
+	}
+	for _, key := range deleted {
+		self.mirror(key, nil, false)
+	}
+
+	self.seq++
+	for _, key := range touched {
+		self.written[string(key)] = self.seq
+	}
+	return versions, nil
+}
+
+// lastWrites drops all but the last write of every key, deletes coming after
+// puts as in a batch. Each key then gets a single new version and revision.
+func lastWrites(keys, values, deleted [][]byte) ([][]byte, [][]byte, [][]byte) {
+	last := make(map[string]int, len(keys)+len(deleted))
+	for i, key := range keys {
+		last[string(key)] = i
+	}
+	for i, key := range deleted {
+		last[string(key)] = len(keys) + i
+	}
+	if len(last) == len(keys)+len(deleted) {
+		return keys, values, deleted
+	}
+	var k, v, d [][]byte
+	for i, key := range keys {
+		if last[string(key)] == i {
+			k = append(k, key)
+			v = append(v, values[i])
+		}
+	}
+	for i, key := range deleted {
+		if last[string(key)] == len(keys)+i {
+			d = append(d, key)
+		}
+	}
+	return k, v, d
+}
+
+// SetChangeLog appends every write made from now on to log, which must be
+// kept in the store of the manager. The changes are written in the same
+// batch as the data.
+func (self *Manager) SetChangeLog(log *changelog.Log) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	self.changes = log
+}
+
+// logChanges numbers the writes to namespaces in the change log. The
+// returned records are to be written together with the data, nil if there
+// are none.
+func (self *Manager) logChanges(keys, values, deleted [][]byte, versions []uint64, now time.Time) (*changelog.Pending, error) {
+	if self.changes == nil {
+		return nil, nil
+	}
+	var changes []changelog.Change
+	for i, dkey := range append(append([][]byte{}, keys...), deleted...) {
+		ns, key, ok := splitDataKey(dkey)
+		if !ok {
+			continue
+		}
+		c := changelog.Change{Time: now, Kind: changelog.KindKV, Namespace: ns, Key: key, Version: versions[i]}
+		if i < len(keys) {
+			c.Value = values[i]
+		} else {
+			c.Deleted = true
+		}
+		changes = append(changes, c)
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return self.changes.Begin(changes)
+}
+
+func versionKey(key []byte) []byte {
+	return append(append([]byte{}, versionPrefix...), key...)
+}
+
+// version returns the version of key, zero if it was never written.
+func (self *Manager) version(key []byte) uint64 {
+	data, err := self.store.Get(versionKey(key))
+	if err != nil || len(data) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(data)
+}
+
+func (self *Manager) preserve(key []byte) {
+	var (
+		old    value
+		loaded bool
+	)
+	for _, tx := range self.txs {
+		if _, ok := tx.snapshot[string(key)]; ok {
+			continue
+		}
+		if !loaded {
+			old, _ = self.get(key)
+			loaded = true
+		}
+		tx.snapshot[string(key)] = old
+	}
+}
+
+func (self *Manager) get(key []byte) (value, error) {
+	data, err := self.store.Get(key)
+	if err == leveldb.ErrNotFound {
+		return value{}, nil
+	}
+	if err != nil {
+		return value{}, err
+	}
+	return value{data, true}, nil
+}
+
+// prune forgets writes that no open transaction can conflict with.
+func (self *Manager) prune() {
+	if len(self.txs) == 0 {
+		self.written = make(map[string]uint64)
+		return
+	}
+	oldest := self.seq
+	for _, tx := range self.txs {
+		if tx.start < oldest {
+			oldest = tx.start
+		}
+	}
+	for key, seq := range self.written {
+		if seq <= oldest {
+			delete(self.written, key)
+		}
+	}
+}
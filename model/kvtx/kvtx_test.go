@@ -0,0 +1,383 @@
+package kvtx
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MonteCarloClub/KBD/crypto"
+	"github.com/MonteCarloClub/KBD/model/changelog"
+	"github.com/MonteCarloClub/KBD/model/kdb"
+	"github.com/MonteCarloClub/KBD/model/trie"
+	"github.com/MonteCarloClub/KBD/rlp"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+type memStore map[string][]byte
+
+func (self memStore) Get(key []byte) ([]byte, error) {
+	if data, ok := self[string(key)]; ok {
+		return data, nil
+	}
+	return nil, leveldb.ErrNotFound
+}
+func (self memStore) Put(key, value []byte) error { self[string(key)] = value; return nil }
+func (self memStore) Delete(key []byte) error     { delete(self, string(key)); return nil }
+func (self memStore) WriteBatch(keys, values, deleted [][]byte) error {
+	for i, key := range keys {
+		self[string(key)] = values[i]
+	}
+	for _, key := range deleted {
+		delete(self, string(key))
+	}
+	return nil
+}
+
+func (self memStore) Scan(prefix, start, end []byte, limit int) (keys, values [][]byte, next []byte, err error) {
+	var all []string
+	for key := range self {
+		k := []byte(key)
+		if bytes.HasPrefix(k, prefix) && bytes.Compare(k, start) >= 0 && (len(end) == 0 || bytes.Compare(k, end) < 0) {
+			all = append(all, key)
+		}
+	}
+	sort.Strings(all)
+	for _, key := range all {
+		if len(keys) == limit {
+			return keys, values, []byte(key), nil
+		}
+		keys = append(keys, []byte(key))
+		values = append(values, self[key])
+	}
+	return keys, values, nil, nil
+}
+
+func TestSnapshotRead(t *testing.T) {
+	store := memStore{"a": []byte("1")}
+	m := NewManager(store)
+
+	id := m.Begin()
+	m.Put([]byte("a"), []byte("2"))
+	m.Put([]byte("b"), []byte("3"))
+
+	if data, ok, _ := m.TxGet(id, []byte("a")); !ok || string(data) != "1" {
+		t.Errorf("expected snapshot value 1, got %q %v", data, ok)
+	}
+	if _, ok, _ := m.TxGet(id, []byte("b")); ok {
+		t.Error("expected b to be absent from the snapshot")
+	}
+	if err := m.Commit(id); err != ErrTxConflict {
+		t.Errorf("expected %v, got %v", ErrTxConflict, err)
+	}
+}
+
+func TestCommit(t *testing.T) {
+	store := memStore{"a": []byte("1"), "b": []byte("2")}
+	m := NewManager(store)
+
+	id := m.Begin()
+	m.TxPut(id, []byte("a"), []byte("10"))
+	m.TxDelete(id, []byte("b"))
+	if data, _, _ := m.TxGet(id, []byte("a")); string(data) != "10" {
+		t.Errorf("expected own write 10, got %q", data)
+	}
+	if string(store["a"]) != "1" {
+		t.Error("write visible before commit")
+	}
+	if err := m.Commit(id); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := store["b"]; ok || string(store["a"]) != "10" {
+		t.Errorf("unexpected store after commit: %q", store)
+	}
+	if err := m.Commit(id); err != ErrTxNotFound {
+		t.Errorf("expected %v, got %v", ErrTxNotFound, err)
+	}
+}
+
+func TestWriteConflict(t *testing.T) {
+	m := NewManager(memStore{})
+
+	first, second := m.Begin(), m.Begin()
+	m.TxPut(first, []byte("a"), []byte("1"))
+	m.TxPut(second, []byte("a"), []byte("2"))
+	if err := m.Commit(first); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Commit(second); err != ErrTxConflict {
+		t.Errorf("expected %v, got %v", ErrTxConflict, err)
+	}
+
+	id := m.Begin()
+	m.TxPut(id, []byte("a"), []byte("3"))
+	if err := m.Rollback(id); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := m.TxGet(id, []byte("a")); err != ErrTxNotFound {
+		t.Errorf("expected %v, got %v", ErrTxNotFound, err)
+	}
+}
+
+func TestCompareAndSwap(t *testing.T) {
+	m := NewManager(memStore{})
+
+	version, err := m.CompareAndSwap([]byte("a"), 0, []byte("1"))
+	if err != nil || version != 1 {
+		t.Fatalf("expected version 1, got %d %v", version, err)
+	}
+	if _, err := m.CompareAndSwap([]byte("a"), 0, []byte("2")); !IsVersionErr(err) {
+		t.Errorf("expected version conflict, got %v", err)
+	}
+	if version, _ = m.Put([]byte("a"), []byte("3")); version != 2 {
+		t.Errorf("expected version 2, got %d", version)
+	}
+	m.Delete([]byte("a"))
+	if version, err = m.CompareAndSwap([]byte("a"), 3, []byte("4")); err != nil || version != 4 {
+		t.Errorf("expected version 4, got %d %v", version, err)
+	}
+	if data, version, _ := m.Get([]byte("a")); string(data) != "4" || version != 4 {
+		t.Errorf("expected 4 at version 4, got %q at %d", data, version)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	store := memStore{}
+	m := NewManager(store)
+	now := time.Unix(1000, 0)
+	m.now = func() time.Time { return now }
+
+	for i, value := range []string{"1", "2", "3"} {
+		now = time.Unix(1000+int64(i)*100, 0)
+		m.Put([]byte("a"), []byte(value))
+	}
+	now = time.Unix(1300, 0)
+	m.Delete([]byte("a"))
+
+	revs := m.History([]byte("a"), 10)
+	if len(revs) != 4 || !revs[0].Deleted || string(revs[1].Data) != "3" {
+		t.Fatalf("unexpected history %+v", revs)
+	}
+	if rev, err := m.GetAtTime([]byte("a"), time.Unix(1150, 0)); err != nil || string(rev.Data) != "2" {
+		t.Errorf("expected 2 at 1150, got %+v %v", rev, err)
+	}
+	if _, err := m.GetAtTime([]byte("a"), time.Unix(999, 0)); err != ErrRevisionNotFound {
+		t.Errorf("expected %v, got %v", ErrRevisionNotFound, err)
+	}
+
+	// Keep what was current at 1150 and everything after.
+	m.retention = 250 * time.Second
+	now = time.Unix(1400, 0)
+	if err := m.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.GetAt([]byte("a"), 1); err != ErrRevisionNotFound {
+		t.Errorf("expected revision 1 to be compacted, got %v", err)
+	}
+	if rev, err := m.GetAtTime([]byte("a"), time.Unix(1150, 0)); err != nil || string(rev.Data) != "2" {
+		t.Errorf("expected 2 at 1150 after compaction, got %+v %v", rev, err)
+	}
+	if revs := m.History([]byte("a"), 10); len(revs) != 3 {
+		t.Errorf("expected 3 revisions after compaction, got %d", len(revs))
+	}
+}
+
+func TestWriteBatchRepeatedKey(t *testing.T) {
+	store := memStore{}
+	log, _ := changelog.New(store)
+	m := NewManager(store)
+	m.SetChangeLog(log)
+	a, b := DataKey("ns", []byte("a")), DataKey("ns", []byte("b"))
+	m.Put(b, []byte("0"))
+	err := m.WriteBatch([][]byte{a, b, a}, [][]byte{[]byte("1"), []byte("2"), []byte("3")}, [][]byte{b})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data, version, _ := m.Get(a); string(data) != "3" || version != 1 {
+		t.Errorf("expected 3 at version 1, got %q at %d", data, version)
+	}
+	if revs := m.History(a, 10); len(revs) != 1 || string(revs[0].Data) != "3" {
+		t.Errorf("unexpected history of a %+v", revs)
+	}
+	if revs := m.History(b, 10); len(revs) != 2 || !revs[0].Deleted || revs[0].Version != 2 {
+		t.Errorf("unexpected history of b %+v", revs)
+	}
+	changes, _ := log.Read(2, 10)
+	if len(changes) != 2 || string(changes[0].Value) != "3" || !changes[1].Deleted || changes[1].Version != 2 {
+		t.Errorf("unexpected changes %+v", changes)
+	}
+}
+
+func TestGetWithProof(t *testing.T) {
+	store := memStore{}
+	roots, _ := kdb.NewMemDatabase()
+	m := NewManager(store)
+	if err := m.EnableTrie(roots, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	m.Put([]byte("a"), []byte("1"))
+	m.Put([]byte("b"), []byte(""))
+	if err := m.RecordRoot(); err != nil {
+		t.Fatal(err)
+	}
+	m.Put([]byte("a"), []byte("2"))
+
+	data, found, root, proof, err := m.GetWithProof([]byte("a"))
+	if err != nil || !found || string(data) != "1" {
+		t.Fatalf("expected a=1 at the recorded root, got %q %v %v", data, found, err)
+	}
+	enc, err := trie.VerifyProof(root[:], crypto.Sha3([]byte("a")), proof)
+	if want, _ := rlp.EncodeToBytes([]byte("1")); err != nil || !bytes.Equal(enc, want) {
+		t.Errorf("proof does not verify: %x %v", enc, err)
+	}
+	if _, found, _, _, _ = m.GetWithProof([]byte("b")); !found {
+		t.Error("expected empty value to be found")
+	}
+
+	// A new manager picks up the recorded root and the writes after it,
+	// whatever the clock says.
+	m = NewManager(store)
+	m.now = func() time.Time { return time.Unix(0, 0) }
+	if err := m.EnableTrie(roots, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if m.Root() != root {
+		t.Errorf("expected recorded root %x, got %x", root, m.Root())
+	}
+	m.RecordRoot()
+	if data, _, _, _, _ := m.GetWithProof([]byte("a")); string(data) != "2" {
+		t.Errorf("expected recovered a=2, got %q", data)
+	}
+	if keys, _, _, _ := store.Scan(journalPrefix, nil, nil, 10); len(keys) != 0 {
+		t.Errorf("expected the journal to be trimmed, got %q", keys)
+	}
+}
+
+func TestNamespaces(t *testing.T) {
+	store := memStore{}
+	m := NewManager(store)
+	if err := m.CreateNamespace("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.CreateNamespace("a"); err != ErrNamespaceExists {
+		t.Errorf("expected ErrNamespaceExists, got %v", err)
+	}
+	if err := m.CreateNamespace("a\x00b"); err != ErrInvalidNamespace {
+		t.Errorf("expected ErrInvalidNamespace, got %v", err)
+	}
+	if names, _ := m.Namespaces(); strings.Join(names, ",") != "a,default" {
+		t.Errorf("unexpected namespaces %v", names)
+	}
+
+	m.Put(DataKey("a", []byte("k1")), []byte("1"))
+	m.Put(DataKey("a", []byte("k2")), []byte("2"))
+	m.Put(DataKey(DefaultNamespace, []byte("k1")), []byte("3"))
+	keys, values, _, err := m.Scan("a", []byte("k"), nil, nil, 10)
+	if err != nil || len(keys) != 2 || string(keys[0]) != "k1" || string(values[1]) != "2" {
+		t.Fatalf("unexpected scan %q %q %v", keys, values, err)
+	}
+
+	if err := m.DropNamespace(DefaultNamespace); err != ErrDropDefault {
+		t.Errorf("expected ErrDropDefault, got %v", err)
+	}
+	if err := m.DropNamespace("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.CheckNamespace("a"); err != ErrNamespaceNotFound {
+		t.Errorf("expected ErrNamespaceNotFound, got %v", err)
+	}
+	if _, _, err := m.Get(DataKey("a", []byte("k1"))); err != leveldb.ErrNotFound {
+		t.Errorf("expected dropped key to be gone, got %v", err)
+	}
+	if data, _, _ := m.Get(DataKey(DefaultNamespace, []byte("k1"))); string(data) != "3" {
+		t.Errorf("expected default namespace untouched, got %q", data)
+	}
+}
+
+func TestIndex(t *testing.T) {
+	m := NewManager(memStore{})
+	put := func(key, data string) {
+		m.Put(DataKey(DefaultNamespace, []byte(key)), []byte(data))
+	}
+	put("card-1", `{"status":"todo","size":3}`)
+	put("card-2", `{"status":"done","size":1}`)
+	put("other", `{"status":"todo"}`)
+
+	idx := Index{Namespace: DefaultNamespace, Name: "status", Prefix: "card-", Path: "status"}
+	if err := m.CreateIndex(idx); err != nil {
+		t.Fatal(err)
+	}
+	m.CreateIndex(Index{Namespace: DefaultNamespace, Name: "size", Prefix: "card-", Path: "size"})
+	put("card-3", `{"status":"todo","size":2}`)
+	put("card-2", `{"status":"todo","size":5}`)
+	m.WriteBatch([][]byte{DataKey(DefaultNamespace, []byte("card-4")), DataKey(DefaultNamespace, []byte("card-4"))},
+		[][]byte{[]byte(`{"status":"todo"}`), []byte(`{"status":"done"}`)}, nil)
+	m.Delete(DataKey(DefaultNamespace, []byte("card-1")))
+
+	keys, _, _, err := m.QueryIndex(DefaultNamespace, "status", IndexQuery{Equals: "todo", Limit: 10})
+	if got := fmt.Sprintf("%s", keys); err != nil || got != "[card-2 card-3]" {
+		t.Errorf("unexpected todo cards %s %v", got, err)
+	}
+
+	// Ranges page through values in order.
+	keys, values, next, _ := m.QueryIndex(DefaultNamespace, "size", IndexQuery{From: float64(2), Limit: 1})
+	if len(keys) != 1 || string(keys[0]) != "card-3" || string(values[0]) != `{"status":"todo","size":2}` || next == nil {
+		t.Fatalf("unexpected first page %s %s", keys, next)
+	}
+	keys, _, next, _ = m.QueryIndex(DefaultNamespace, "size", IndexQuery{From: float64(2), Cursor: next, Limit: 1})
+	if len(keys) != 1 || string(keys[0]) != "card-2" || next != nil {
+		t.Errorf("unexpected second page %s %s", keys, next)
+	}
+	if _, _, _, err := m.QueryIndex(DefaultNamespace, "size", IndexQuery{From: float64(1), To: "a"}); err != ErrInvalidQuery {
+		t.Errorf("expected ErrInvalidQuery, got %v", err)
+	}
+
+	if err := m.DropIndex(DefaultNamespace, "status"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := m.QueryIndex(DefaultNamespace, "status", IndexQuery{Equals: "todo"}); err != ErrIndexNotFound {
+		t.Errorf("expected ErrIndexNotFound, got %v", err)
+	}
+}
+
+func TestChangeLog(t *testing.T) {
+	store := memStore{}
+	log, _ := changelog.New(store)
+	m := NewManager(store)
+	m.SetChangeLog(log)
+	m.Put(DataKey("ns", []byte("a")), []byte("1"))
+	m.Delete(DataKey("ns", []byte("a")))
+	m.Put([]byte("raw"), []byte("2"))
+
+	changes, _ := log.Read(1, 10)
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %+v", changes)
+	}
+	if c := changes[1]; c.Namespace != "ns" || string(c.Key) != "a" || !c.Deleted || c.Version != 2 {
+		t.Errorf("unexpected change %+v", c)
+	}
+}
+
+func TestImport(t *testing.T) {
+	store := memStore{}
+	log, _ := changelog.New(store)
+	m := NewManager(store)
+	m.SetChangeLog(log)
+	key := DataKey("ns", []byte("a"))
+	if err := m.Import([][]byte{key}, [][]byte{[]byte("1")}); err != nil {
+		t.Fatal(err)
+	}
+
+	if data, version, err := m.Get(key); err != nil || string(data) != "1" || version != 1 {
+		t.Errorf("expected 1 at version 1, got %q at %d (%v)", data, version, err)
+	}
+	if revs := m.History(key, 10); len(revs) != 0 {
+		t.Errorf("expected no revisions, got %+v", revs)
+	}
+	if changes, _ := log.Read(1, 10); len(changes) != 0 {
+		t.Errorf("expected no changes, got %+v", changes)
+	}
+}
@@ -0,0 +1,186 @@
+package kvtx
+
+import (
+	"encoding/binary"
+	"errors"
+	"regexp"
+	"sort"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// DefaultNamespace is used by requests that do not name a namespace. It
+// always exists and cannot be dropped.
+const DefaultNamespace = "default"
+
+var (
+	ErrInvalidNamespace  = errors.New("invalid namespace name")
+	ErrNamespaceNotFound = errors.New("namespace not found")
+	ErrNamespaceExists   = errors.New("namespace already exists")
+	ErrDropDefault       = errors.New("the default namespace cannot be dropped")
+)
+
+var (
+	// dataPrefix prefixes the keys data is stored under, as
+	// dataPrefix | namespace | 0 | key.
+	dataPrefix = []byte("d")
+	// namespacePrefix prefixes the keys namespaces are registered under,
+	// holding the time they were created at.
+	namespacePrefix = []byte("n")
+)
+
+var namespaceName = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+
+// DataKey returns the store key of key in namespace ns. Names cannot contain
+// a zero byte, so no two namespaces share a key.
+func DataKey(ns string, key []byte) []byte {
+	dkey := make([]byte, 0, len(dataPrefix)+len(ns)+1+len(key))
+	dkey = append(append(dkey, dataPrefix...), ns...)
+	return append(append(dkey, 0), key...)
+}
+
+func namespaceKey(ns string) []byte {
+	return append(append([]byte{}, namespacePrefix...), ns...)
+}
+
+func (self *Manager) hasNamespace(ns string) (bool, error) {
+	if ns == DefaultNamespace {
+		return true, nil
+	}
+	_, err := self.store.Get(namespaceKey(ns))
+	if err == leveldb.ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// CheckNamespace returns ErrNamespaceNotFound unless ns exists.
+func (self *Manager) CheckNamespace(ns string) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	ok, err := self.hasNamespace(ns)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNamespaceNotFound
+	}
+	return nil
+}
+
+func (self *Manager) CreateNamespace(ns string) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if !namespaceName.MatchString(ns) {
+		return ErrInvalidNamespace
+	}
+	ok, err := self.hasNamespace(ns)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return ErrNamespaceExists
+	}
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint64(data, uint64(self.now().UnixNano()))
+	return self.store.Put(namespaceKey(ns), data)
+}
+
+// Namespaces returns the names of all namespaces in order, the default one
+// included.
+func (self *Manager) Namespaces() ([]string, error) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	names := []string{DefaultNamespace}
+	var start []byte
+	for {
+		keys, _, next, err := self.store.Scan(namespacePrefix, start, nil, 1000)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			names = append(names, string(key[len(namespacePrefix):]))
+		}
+		if next == nil {
+			break
+		}
+		start = next
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// DropNamespace deletes every key of ns and then the namespace itself. The
+// deletes are versioned like any other, so the history of the keys is kept
+// until it is compacted.
+func (self *Manager) DropNamespace(ns string) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if ns == DefaultNamespace {
+		return ErrDropDefault
+	}
+	ok, err := self.hasNamespace(ns)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNamespaceNotFound
+	}
+
+	prefix := DataKey(ns, nil)
+	dropped := 0
+	for {
+		keys, _, _, err := self.store.Scan(prefix, nil, nil, 1000)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			break
+		}
+		if _, err := self.write(nil, nil, keys); err != nil {
+			return err
+		}
+		dropped += len(keys)
+	}
+	if err := self.loadIndexes(); err != nil {
+		return err
+	}
+	for _, idx := range self.indexes[ns] {
+		if err := self.dropIndex(ns, idx.Name); err != nil {
+			return err
+		}
+	}
+	if err := self.store.Delete(namespaceKey(ns)); err != nil {
+		return err
+	}
+	klog.Infof("[DropNamespace] dropped %s with %d keys", ns, dropped)
+	return nil
+}
+
+// Scan returns a page of the keys of ns matching prefix in [start, end),
+// with the namespace stripped from them and from next.
+func (self *Manager) Scan(ns string, prefix, start, end []byte, limit int) (keys, values [][]byte, next []byte, err error) {
+	if len(start) > 0 {
+		start = DataKey(ns, start)
+	}
+	if len(end) > 0 {
+		end = DataKey(ns, end)
+	}
+	keys, values, next, err = self.store.Scan(DataKey(ns, prefix), start, end, limit)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	base := len(DataKey(ns, nil))
+	for i, key := range keys {
+		keys[i] = key[base:]
+	}
+	if next != nil {
+		next = next[base:]
+	}
+	return keys, values, next, nil
+}
@@ -0,0 +1,252 @@
+package kvtx
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/model/trie"
+	"github.com/MonteCarloClub/KBD/rlp"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+var ErrNoTrie = errors.New("kv trie is not enabled")
+
+// rootKey is the key the last recorded root of the KV trie is kept under,
+// followed by the write sequence number it includes.
+var rootKey = []byte("kv-root")
+
+// journalPrefix prefixes the keys the writes to the KV trie are journaled
+// under, followed by their write sequence number. The journal holds the keys
+// written and is trimmed whenever a root is recorded.
+var journalPrefix = []byte("j")
+
+func journalKey(seq uint64) []byte {
+	key := make([]byte, len(journalPrefix)+8)
+	copy(key, journalPrefix)
+	binary.BigEndian.PutUint64(key[len(journalPrefix):], seq)
+	return key
+}
+
+// nodePrefix prefixes the keys the nodes of the KV trie are stored under,
+// keeping them apart from the data.
+var nodePrefix = []byte("t")
+
+// nodeStore is the backend of the KV trie. The trie keeps its nodes until it
+// is committed, which writes them to the store as a single batch.
+type nodeStore struct {
+	store Store
+}
+
+func newNodeStore(store Store) *nodeStore {
+	return &nodeStore{store: store}
+}
+
+func nodeKey(key []byte) []byte {
+	return append(append([]byte{}, nodePrefix...), key...)
+}
+
+func (self *nodeStore) Get(key []byte) ([]byte, error) {
+	return self.store.Get(nodeKey(key))
+}
+
+func (self *nodeStore) Put(key, value []byte) error {
+	return self.store.WriteBatch([][]byte{nodeKey(key)}, [][]byte{value}, nil)
+}
+
+func (self *nodeStore) NewBatch() common.Batch {
+	return &nodeBatch{store: self.store}
+}
+
+// nodeBatch collects node writes for Store.WriteBatch.
+type nodeBatch struct {
+	store                 Store
+	keys, values, deleted [][]byte
+}
+
+func (self *nodeBatch) Put(key, value []byte) error {
+	self.keys = append(self.keys, nodeKey(key))
+	self.values = append(self.values, common.CopyBytes(value))
+	return nil
+}
+
+func (self *nodeBatch) Delete(key []byte) error {
+	self.deleted = append(self.deleted, nodeKey(key))
+	return nil
+}
+
+func (self *nodeBatch) Write() error {
+	if len(self.keys) == 0 && len(self.deleted) == 0 {
+		return nil
+	}
+	return self.store.WriteBatch(self.keys, self.values, self.deleted)
+}
+
+func (self *nodeBatch) Reset() {
+	self.keys, self.values, self.deleted = nil, nil, nil
+}
+
+// EnableTrie mirrors the keyspace into a SecureTrie, holding the RLP encoded
+// values, and records its root in roots every interval.
+// Writes made after the last recorded root are recovered from the journal.
+func (self *Manager) EnableTrie(roots common.Database, interval time.Duration) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if data, err := roots.Get(rootKey); err == nil && len(data) == 40 {
+		self.root = common.BytesToHash(data[:32])
+		self.rootSeq = binary.BigEndian.Uint64(data[32:])
+		self.kv = trie.NewSecure(self.root[:], self.nodes)
+	} else {
+		self.kv = trie.NewSecure(nil, self.nodes)
+		self.root = common.BytesToHash(self.kv.Root())
+	}
+	self.writeSeq = self.rootSeq
+	self.roots = roots
+	if err := self.recoverTrie(); err != nil {
+		return err
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			if err := self.RecordRoot(); err != nil {
+				klog.Errorf("[RecordRoot] record kv root failed %v", err)
+			}
+		}
+	}()
+	return nil
+}
+
+// recoverTrie applies the current values of the keys journaled after the
+// last recorded root.
+func (self *Manager) recoverTrie() error {
+	touched := make(map[string]struct{})
+	start := journalKey(self.rootSeq + 1)
+	for {
+		keys, values, next, err := self.store.Scan(journalPrefix, start, nil, 1000)
+		if err != nil {
+			return err
+		}
+		for i, jkey := range keys {
+			var written [][]byte
+			if err := rlp.DecodeBytes(values[i], &written); err != nil {
+				return err
+			}
+			for _, key := range written {
+				touched[string(key)] = struct{}{}
+			}
+			self.writeSeq = binary.BigEndian.Uint64(jkey[len(journalPrefix):])
+		}
+		if next == nil {
+			break
+		}
+		start = next
+	}
+	for key := range touched {
+		v, err := self.get([]byte(key))
+		if err != nil {
+			return err
+		}
+		self.mirror([]byte(key), v.data, v.exists)
+	}
+	if len(touched) > 0 {
+		klog.Infof("[recoverTrie] recovered %d keys", len(touched))
+	}
+	return nil
+}
+
+// mirror applies a write to the KV trie, if there is one.
+func (self *Manager) mirror(key, data []byte, exists bool) {
+	if self.kv == nil {
+		return
+	}
+	if !exists {
+		self.kv.Delete(key)
+		return
+	}
+	enc, _ := rlp.EncodeToBytes(data)
+	self.kv.Update(key, enc)
+}
+
+// RecordRoot commits the KV trie and records its root, then trims the
+// journal of the writes it includes.
+func (self *Manager) RecordRoot() error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	if self.kv == nil {
+		return nil
+	}
+	batch := self.nodes.NewBatch()
+	self.kv.CommitTo(batch)
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	self.kv.Committed()
+	root := common.BytesToHash(self.kv.Root())
+	if root == self.root && self.writeSeq == self.rootSeq {
+		return nil
+	}
+
+	data := make([]byte, 40)
+	copy(data, root[:])
+	binary.BigEndian.PutUint64(data[32:], self.writeSeq)
+	if err := self.roots.Put(rootKey, data); err != nil {
+		return err
+	}
+	if err := self.roots.Flush(); err != nil {
+		return err
+	}
+	self.root, self.rootSeq = root, self.writeSeq
+	// Start over with an empty cache, the committed nodes are in the store.
+	self.kv = trie.NewSecure(root[:], self.nodes)
+	return self.trimJournal()
+}
+
+// trimJournal removes the journaled writes included in the recorded root.
+func (self *Manager) trimJournal() error {
+	end := journalKey(self.rootSeq + 1)
+	for {
+		keys, _, next, err := self.store.Scan(journalPrefix, nil, end, 1000)
+		if err != nil || len(keys) == 0 {
+			return err
+		}
+		if err := self.store.WriteBatch(nil, nil, keys); err != nil {
+			return err
+		}
+		if next == nil {
+			return nil
+		}
+	}
+}
+
+// Root returns the last recorded root of the KV trie.
+func (self *Manager) Root() common.Hash {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	return self.root
+}
+
+// GetWithProof returns the value of key as of the last recorded root, and a
+// proof of it, or of its absence, against that root.
+func (self *Manager) GetWithProof(key []byte) (data []byte, found bool, root common.Hash, proof [][]byte, err error) {
+	self.mu.Lock()
+	enabled, root := self.kv != nil, self.root
+	self.mu.Unlock()
+	if !enabled {
+		return nil, false, root, nil, ErrNoTrie
+	}
+
+	t := trie.NewSecure(root[:], self.nodes)
+	if enc := t.Get(key); len(enc) > 0 {
+		if err = rlp.DecodeBytes(enc, &data); err != nil {
+			return nil, false, root, nil, err
+		}
+		found = true
+	}
+	return data, found, root, t.Prove(key), nil
+}
@@ -0,0 +1,215 @@
+package sink
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/MonteCarloClub/KBD/model/event"
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const defaultBatchSize = 100
+
+var (
+	// MinBackoff and MaxBackoff bound the wait before a failed write is
+	// retried. The wait doubles with every failure in a row.
+	MinBackoff = 500 * time.Millisecond
+	MaxBackoff = time.Minute
+)
+
+// Spool is the store records wait in until their sink has taken them.
+type Spool interface {
+	WriteBatch(keys, values, deleted [][]byte) error
+	Scan(prefix, start, end []byte, limit int) (keys, values [][]byte, next []byte, err error)
+}
+
+// Encoder turns an event into the records sinks get, none if the event is
+// of no interest.
+type Encoder func(ev interface{}) []Record
+
+// Dispatcher subscribes to events on a mux and delivers them to a sink. Every
+// record is spooled before it is handed on and only removed once the sink
+// took it, so records survive restarts and failures of the sink and are
+// delivered at least once.
+type Dispatcher struct {
+	name   string
+	sink   Sink
+	spool  Spool
+	encode Encoder
+	batch  int
+
+	sub    event.Subscription
+	mu     sync.Mutex
+	nextID uint64
+	wake   chan struct{}
+	quit   chan struct{}
+	done   sync.WaitGroup
+}
+
+// NewDispatcher starts delivering the events of the given types to sink.
+// Records still spooled under name from a previous run are delivered first.
+func NewDispatcher(name string, sink Sink, spool Spool, mux *event.TypeMux, types []interface{}, encode Encoder, batch int) (*Dispatcher, error) {
+	if batch <= 0 {
+		batch = defaultBatchSize
+	}
+	self := &Dispatcher{
+		name:   name,
+		sink:   sink,
+		spool:  spool,
+		encode: encode,
+		batch:  batch,
+		nextID: 1,
+		wake:   make(chan struct{}, 1),
+		quit:   make(chan struct{}),
+	}
+	if err := self.loadNextID(); err != nil {
+		return nil, err
+	}
+	self.sub = mux.Subscribe(types...)
+
+	self.done.Add(2)
+	go self.receive()
+	go self.deliver()
+	return self, nil
+}
+
+func (self *Dispatcher) prefix() []byte {
+	return append([]byte(self.name), 0)
+}
+
+func (self *Dispatcher) recordKey(id uint64) []byte {
+	key := append(self.prefix(), make([]byte, 8)...)
+	binary.BigEndian.PutUint64(key[len(key)-8:], id)
+	return key
+}
+
+// loadNextID continues the ids after the records left in the spool.
+func (self *Dispatcher) loadNextID() error {
+	var start []byte
+	for {
+		keys, _, next, err := self.spool.Scan(self.prefix(), start, nil, 1000)
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			last := keys[len(keys)-1]
+			self.nextID = binary.BigEndian.Uint64(last[len(last)-8:]) + 1
+		}
+		if next == nil {
+			return nil
+		}
+		start = next
+	}
+}
+
+func (self *Dispatcher) receive() {
+	defer self.done.Done()
+
+	for ev := range self.sub.Chan() {
+		records := self.encode(ev)
+		if len(records) == 0 {
+			continue
+		}
+		if err := self.add(records); err != nil {
+			klog.Errorf("[Dispatcher] %s: spool %d records failed %v", self.name, len(records), err)
+			continue
+		}
+		select {
+		case self.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (self *Dispatcher) add(records []Record) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	keys := make([][]byte, len(records))
+	values := make([][]byte, len(records))
+	for i := range records {
+		records[i].ID = self.nextID + uint64(i)
+		if records[i].Time.IsZero() {
+			records[i].Time = time.Now()
+		}
+		data, err := json.Marshal(records[i])
+		if err != nil {
+			return err
+		}
+		keys[i], values[i] = self.recordKey(records[i].ID), data
+	}
+	if err := self.spool.WriteBatch(keys, values, nil); err != nil {
+		return err
+	}
+	self.nextID += uint64(len(records))
+	return nil
+}
+
+func (self *Dispatcher) deliver() {
+	defer self.done.Done()
+
+	backoff := MinBackoff
+	for {
+		keys, records, err := self.pending()
+		if err == nil && len(records) == 0 {
+			select {
+			case <-self.wake:
+				continue
+			case <-self.quit:
+				return
+			}
+		}
+		if err == nil {
+			err = self.sink.Write(records)
+		}
+		if err == nil {
+			err = self.spool.WriteBatch(nil, nil, keys)
+		}
+		if err == nil {
+			backoff = MinBackoff
+			continue
+		}
+
+		klog.Warnf("[Dispatcher] %s: delivery failed, retrying in %v: %v", self.name, backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-self.quit:
+			return
+		}
+		if backoff *= 2; backoff > MaxBackoff {
+			backoff = MaxBackoff
+		}
+	}
+}
+
+// pending returns the oldest spooled records, at most one batch.
+func (self *Dispatcher) pending() ([][]byte, []Record, error) {
+	keys, values, _, err := self.spool.Scan(self.prefix(), nil, nil, self.batch)
+	if err != nil {
+		return nil, nil, err
+	}
+	records := make([]Record, len(values))
+	for i, data := range values {
+		// Data comes back as plain JSON, which is all sinks need.
+		var raw struct {
+			Record
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, nil, err
+		}
+		records[i] = raw.Record
+		records[i].Data = raw.Data
+	}
+	return keys, records, nil
+}
+
+// Stop ends delivery and closes the sink. Spooled records are kept.
+func (self *Dispatcher) Stop() error {
+	self.sub.Unsubscribe()
+	close(self.quit)
+	self.done.Wait()
+	return self.sink.Close()
+}
@@ -0,0 +1,136 @@
+package sink
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+const (
+	defaultMaxSize  = 64 << 20
+	defaultMaxFiles = 10
+)
+
+// FileSink appends records as JSON lines to a file. Once the file would grow
+// past maxSize it is renamed with the time as suffix and a new one started,
+// keeping at most maxFiles of the renamed ones.
+type FileSink struct {
+	path     string
+	maxSize  int64
+	maxFiles int
+
+	f    *os.File
+	size int64
+}
+
+func NewFileSink(path string, maxSize int64, maxFiles int) (*FileSink, error) {
+	if maxSize <= 0 {
+		maxSize = defaultMaxSize
+	}
+	if maxFiles <= 0 {
+		maxFiles = defaultMaxFiles
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	self := &FileSink{path: path, maxSize: maxSize, maxFiles: maxFiles}
+	if err := self.open(); err != nil {
+		return nil, err
+	}
+	return self, nil
+}
+
+func (self *FileSink) open() error {
+	f, err := os.OpenFile(self.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	self.f, self.size = f, info.Size()
+	return nil
+}
+
+// Write appends the records and syncs the file.
+func (self *FileSink) Write(records []Record) error {
+	var buf []byte
+	for _, r := range records {
+		line, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		if self.size+int64(len(buf)+len(line)+1) > self.maxSize && self.size+int64(len(buf)) > 0 {
+			if err := self.flush(buf); err != nil {
+				return err
+			}
+			buf = nil
+			if err := self.rotate(); err != nil {
+				return err
+			}
+		}
+		buf = append(append(buf, line...), '\n')
+	}
+	return self.flush(buf)
+}
+
+func (self *FileSink) flush(buf []byte) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	n, err := self.f.Write(buf)
+	self.size += int64(n)
+	if err != nil {
+		return err
+	}
+	return self.f.Sync()
+}
+
+// rotate renames the file and starts a new one. The current file is kept
+// open until the new one is, so a failed rotation leaves the sink writable.
+func (self *FileSink) rotate() error {
+	rotated := self.path + "." + time.Now().UTC().Format("20060102T150405.000000000")
+	// The file may have been removed from under the sink, then there is
+	// nothing to keep.
+	renamed := true
+	if err := os.Rename(self.path, rotated); os.IsNotExist(err) {
+		renamed = false
+	} else if err != nil {
+		return err
+	}
+	f := self.f
+	if err := self.open(); err != nil {
+		if renamed {
+			os.Rename(rotated, self.path)
+		}
+		return err
+	}
+	if err := f.Close(); err != nil {
+		klog.Warnf("[FileSink] close %s failed %v", rotated, err)
+	}
+
+	old, err := filepath.Glob(self.path + ".*")
+	if err != nil {
+		klog.Warnf("[FileSink] list rotated files of %s failed %v", self.path, err)
+		return nil
+	}
+	// The suffixes sort by time.
+	sort.Strings(old)
+	for len(old) > self.maxFiles {
+		if err := os.Remove(old[0]); err != nil {
+			klog.Warnf("[FileSink] remove %s failed %v", old[0], err)
+		}
+		old = old[1:]
+	}
+	return nil
+}
+
+func (self *FileSink) Close() error {
+	return self.f.Close()
+}
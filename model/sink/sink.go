@@ -0,0 +1,56 @@
+package sink
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrUnknownSink = errors.New("unknown sink type")
+
+// Record is a single event as delivered to sinks. ID grows by one with every
+// record a dispatcher takes in, so consumers can drop the duplicates at-least
+// once delivery may produce.
+type Record struct {
+	ID   uint64      `json:"id"`
+	Type string      `json:"type"`
+	Time time.Time   `json:"time"`
+	Data interface{} `json:"data"`
+}
+
+// Sink delivers records somewhere. Write either takes all records or fails,
+// in which case they are written again later.
+type Sink interface {
+	Write(records []Record) error
+	Close() error
+}
+
+// Config describes a sink and the events it gets.
+type Config struct {
+	Name   string   `json:"name"`
+	Type   string   `json:"type"`
+	Events []string `json:"events"`
+
+	// BatchSize is the most records written at once.
+	BatchSize int `json:"batchSize"`
+
+	// Path, MaxSize and MaxFiles configure file sinks.
+	Path     string `json:"path"`
+	MaxSize  int64  `json:"maxSize"`
+	MaxFiles int    `json:"maxFiles"`
+
+	// URL, Headers and TimeoutMs configure webhook sinks.
+	URL       string            `json:"url"`
+	Headers   map[string]string `json:"headers"`
+	TimeoutMs int               `json:"timeoutMs"`
+}
+
+func (self Config) NewSink() (Sink, error) {
+	switch self.Type {
+	case "file":
+		return NewFileSink(self.Path, self.MaxSize, self.MaxFiles)
+	case "webhook":
+		return NewWebhookSink(self.URL, self.Headers, time.Duration(self.TimeoutMs)*time.Millisecond), nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownSink, self.Type)
+}
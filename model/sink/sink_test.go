@@ -0,0 +1,164 @@
+package sink
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MonteCarloClub/KBD/model/event"
+	"github.com/MonteCarloClub/KBD/model/kdb"
+)
+
+func TestFileSinkRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events", "chain.log")
+	sink, err := NewFileSink(path, 100, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+	for i := 0; i < 10; i++ {
+		if err := sink.Write([]Record{{ID: uint64(i), Type: "test", Data: "0123456789"}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rotated, _ := filepath.Glob(path + ".*")
+	if len(rotated) != 2 {
+		t.Errorf("expected 2 rotated files, got %v", rotated)
+	}
+	if info, err := os.Stat(path); err != nil || info.Size() > 100 {
+		t.Errorf("expected current file within max size, got %v %v", info, err)
+	}
+}
+
+func TestFileSinkRemoved(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chain.log")
+	sink, err := NewFileSink(path, 100, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+	record := Record{Type: "test", Data: "0123456789"}
+	sink.Write([]Record{record})
+
+	// Rotation starts a new file when the current one has been removed.
+	os.Remove(path)
+	for i := 0; i < 4; i++ {
+		if err := sink.Write([]Record{record}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if info, err := os.Stat(path); err != nil || info.Size() == 0 {
+		t.Errorf("expected a new file, got %v %v", info, err)
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	var got []Record
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Token") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.NewDecoder(r.Body).Decode(&got)
+	}))
+	defer srv.Close()
+
+	if err := NewWebhookSink(srv.URL, nil, 0).Write([]Record{{ID: 1}}); err == nil {
+		t.Error("expected unauthorized write to fail")
+	}
+	sink := NewWebhookSink(srv.URL, map[string]string{"X-Token": "secret"}, 0)
+	if err := sink.Write([]Record{{ID: 1, Type: "a"}, {ID: 2, Type: "b"}}); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[1].Type != "b" {
+		t.Errorf("unexpected records %+v", got)
+	}
+}
+
+type flakySink struct {
+	mu       sync.Mutex
+	failures int
+	ids      []uint64
+}
+
+func (self *flakySink) Write(records []Record) error {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	if self.failures > 0 {
+		self.failures--
+		return errors.New("unavailable")
+	}
+	for _, r := range records {
+		self.ids = append(self.ids, r.ID)
+	}
+	return nil
+}
+func (self *flakySink) Close() error { return nil }
+
+func (self *flakySink) delivered() []uint64 {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	return append([]uint64{}, self.ids...)
+}
+
+type testEvent struct{ N int }
+
+func TestDispatcherRetry(t *testing.T) {
+	MinBackoff, MaxBackoff = time.Millisecond, 5*time.Millisecond
+	spool, err := kdb.NewLDBDatabase(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	encode := func(ev interface{}) []Record {
+		return []Record{{Type: "testEvent", Data: ev}}
+	}
+	mux := new(event.TypeMux)
+
+	sink := &flakySink{failures: 3}
+	d, err := NewDispatcher("test", sink, spool, mux, []interface{}{testEvent{}}, encode, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		mux.Post(testEvent{i})
+	}
+	waitFor(t, func() bool { return len(sink.delivered()) == 5 })
+	if ids := sink.delivered(); ids[0] != 1 || ids[4] != 5 {
+		t.Errorf("unexpected delivery order %v", ids)
+	}
+
+	// Records the sink never took are delivered after a restart.
+	sink.mu.Lock()
+	sink.failures = 1 << 30
+	sink.mu.Unlock()
+	mux.Post(testEvent{5})
+	time.Sleep(10 * time.Millisecond)
+	d.Stop()
+
+	sink = &flakySink{}
+	d, err = NewDispatcher("test", sink, spool, mux, []interface{}{testEvent{}}, encode, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Stop()
+	mux.Post(testEvent{6})
+	waitFor(t, func() bool { return len(sink.delivered()) == 2 })
+	if ids := sink.delivered(); ids[0] != 6 || ids[1] != 7 {
+		t.Errorf("expected spooled record 6 then 7, got %v", ids)
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if cond() {
+			return
+		}
+	}
+	t.Fatal("timed out")
+}
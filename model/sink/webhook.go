@@ -0,0 +1,58 @@
+package sink
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// WebhookSink posts records as a JSON array to an HTTP endpoint. Any status
+// other than 2xx fails the write.
+type WebhookSink struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func NewWebhookSink(url string, headers map[string]string, timeout time.Duration) *WebhookSink {
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	return &WebhookSink{url: url, headers: headers, client: &http.Client{Timeout: timeout}}
+}
+
+func (self *WebhookSink) Write(records []Record) error {
+	body, err := json.Marshal(records)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, self.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range self.headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := self.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook %s: %s", self.url, resp.Status)
+	}
+	return nil
+}
+
+func (self *WebhookSink) Close() error {
+	self.client.CloseIdleConnections()
+	return nil
+}
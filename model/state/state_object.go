@@ -314,6 +314,36 @@ func (self *StateObject) EachStorage(cb func(key, value []byte)) {
 	}
 }
 
+// StorageEntry is a slot of a storage trie. Key is the trie key, the hash of
+// Slot. Slot is nil if its preimage is unknown.
+type StorageEntry struct {
+	Key   common.Hash
+	Slot  []byte
+	Value common.Hash
+}
+
+// StorageRange returns up to limit entries of the storage trie following
+// cursor, and the cursor of the next range or nil if there is none.
+func (self *StateObject) StorageRange(cursor []byte, limit int) (entries []StorageEntry, next []byte) {
+	it := self.trie.Iterator()
+	if len(cursor) > 0 {
+		it.Key = common.CopyBytes(cursor)
+	}
+	for it.Next() {
+		if len(entries) == limit {
+			return entries, entries[limit-1].Key.Bytes()
+		}
+		var value []byte
+		rlp.DecodeBytes(it.Value, &value)
+		entries = append(entries, StorageEntry{
+			Key:   common.BytesToHash(it.Key),
+			Slot:  self.trie.GetKey(it.Key),
+			Value: common.BytesToHash(value),
+		})
+	}
+	return entries, nil
+}
+
 //
 // Encoding
 //
@@ -100,3 +100,34 @@ func (s *StateSuite) TestSnapshot(c *checker.C) {
 
 	c.Assert(data1, checker.DeepEquals, res)
 }
+
+func TestStorageRange(t *testing.T) {
+	db, _ := kdb.NewMemDatabase()
+	obj := NewStateObject(toAddr([]byte{0x01}), db)
+	for i := byte(1); i <= 5; i++ {
+		obj.SetState(common.BytesToHash([]byte{i}), common.BytesToHash([]byte{i + 10}))
+	}
+	obj.Update()
+
+	var (
+		all    []StorageEntry
+		cursor []byte
+	)
+	for {
+		entries, next := obj.StorageRange(cursor, 2)
+		all = append(all, entries...)
+		if next == nil {
+			break
+		}
+		cursor = next
+	}
+	if len(all) != 5 {
+		t.Fatalf("expected 5 entries, got %d", len(all))
+	}
+	for _, entry := range all {
+		slot := common.BytesToHash(entry.Slot)
+		if want := obj.GetState(slot); entry.Value != want {
+			t.Errorf("slot %x: expected %x, got %x", slot, want, entry.Value)
+		}
+	}
+}
@@ -306,11 +306,21 @@ func (s *StateDB) Reset() {
 
 // Syncs the trie and all siblings
 func (s *StateDB) Sync() {
-	// Sync all nested states
+	// Sync all nested states together with the state trie in one batch
+	batch := s.db.NewBatch()
 	for _, stateObject := range s.stateObjects {
-		stateObject.trie.Commit()
+		stateObject.trie.CommitTo(batch)
 	}
-	s.trie.Commit()
+	s.trie.CommitTo(batch)
+	if err := batch.Write(); err != nil {
+		// Keep the state objects, the next sync writes their nodes again.
+		klog.Errorf("[Sync] write state failed %v", err)
+		return
+	}
+	for _, stateObject := range s.stateObjects {
+		stateObject.trie.Committed()
+	}
+	s.trie.Committed()
 	s.Empty()
 }
 
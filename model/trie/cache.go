@@ -1,25 +1,28 @@
 package trie
 
 import (
-	"github.com/MonteCarloClub/KBD/compression/rle"
-	"github.com/MonteCarloClub/KBD/model/kdb"
+	"github.com/MonteCarloClub/KBD/common"
 	"github.com/cloudwego/kitex/pkg/klog"
-	"github.com/syndtr/goleveldb/leveldb"
 )
 
 type Backend interface {
 	Get([]byte) ([]byte, error)
 	Put([]byte, []byte) error
+	NewBatch() common.Batch
 }
 
 type Cache struct {
-	batch   *leveldb.Batch
 	store   map[string][]byte
+	pending map[string][]byte
 	backend Backend
+
+	// flushing holds the nodes added to a batch by FlushTo, they stay
+	// pending until Flushed.
+	flushing map[string][]byte
 }
 
 func NewCache(backend Backend) *Cache {
-	return &Cache{new(leveldb.Batch), make(map[string][]byte), backend}
+	return &Cache{store: make(map[string][]byte), pending: make(map[string][]byte), backend: backend}
 }
 
 func (self *Cache) Get(key []byte) []byte {
@@ -32,30 +35,49 @@ func (self *Cache) Get(key []byte) []byte {
 }
 
 func (self *Cache) Put(key []byte, data []byte) {
-	// write the data to the ldb batch
-	self.batch.Put(key, rle.Compress(data))
 	self.store[string(key)] = data
+	self.pending[string(key)] = data
 }
 
-// Flush flushes the trie to the backing layer. If this is a leveldb instance
-// we'll use a batched write, otherwise we'll use regular put.
+// Flush writes the nodes put since the last flush to the backing layer in a
+// single batch. They are written again by the next flush if it fails.
 func (self *Cache) Flush() {
-	if db, ok := self.backend.(*kdb.LDBDatabase); ok {
-		if err := db.LDB().Write(self.batch, nil); err != nil {
-			klog.Error("db write err:", err)
-		}
-	} else {
-		for k, v := range self.store {
-			self.backend.Put([]byte(k), v)
-		}
+	batch := self.backend.NewBatch()
+	self.FlushTo(batch)
+	if err := batch.Write(); err != nil {
+		klog.Error("db write err:", err)
+		return
+	}
+	self.Flushed()
+}
+
+// FlushTo adds the nodes put since the last flush to batch, leaving it to the
+// caller to write it and to call Flushed once it has.
+func (self *Cache) FlushTo(batch common.Batch) {
+	self.flushing = make(map[string][]byte, len(self.pending))
+	for k, v := range self.pending {
+		batch.Put([]byte(k), v)
+		self.flushing[k] = v
 	}
 }
 
+// Flushed drops the nodes added to a batch by the last FlushTo from the
+// pending ones, the batch having been written.
+func (self *Cache) Flushed() {
+	for k := range self.flushing {
+		delete(self.pending, k)
+	}
+	self.flushing = nil
+}
+
 func (self *Cache) Copy() *Cache {
 	cache := NewCache(self.backend)
 	for k, v := range self.store {
 		cache.store[k] = v
 	}
+	for k, v := range self.pending {
+		cache.pending[k] = v
+	}
 	return cache
 }
 
@@ -0,0 +1,114 @@
+package trie
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/crypto"
+)
+
+var (
+	ErrMissingProofNode = errors.New("trie: proof node missing")
+	ErrInvalidProof     = errors.New("trie: invalid proof node")
+)
+
+// Prove returns the RLP encoded nodes on the path from the root to key. The
+// first node is the root, every following node is referenced by hash from
+// the one before it. Nodes small enough to be embedded in their parent are
+// not repeated. The path ends early if key is not in the trie, which proves
+// its absence.
+func (self *Trie) Prove(key []byte) [][]byte {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	k := CompactHexDecode(string(key))
+	var proof [][]byte
+	for node := self.root; node != nil; {
+		data := common.Encode(node)
+		if len(proof) == 0 || len(data) >= 32 {
+			proof = append(proof, data)
+		}
+
+		switch n := node.(type) {
+		case *ShortNode:
+			nk := n.Key()
+			if len(k) < len(nk) || !bytes.Equal(nk, k[:len(nk)]) {
+				return proof
+			}
+			if _, ok := n.Value().(*ValueNode); ok {
+				return proof
+			}
+			k = k[len(nk):]
+			node = n.Value()
+		case *FullNode:
+			if len(k) == 0 {
+				return proof
+			}
+			node = n.branch(k[0])
+			k = k[1:]
+		default:
+			return proof
+		}
+	}
+	return proof
+}
+
+func (self *SecureTrie) Prove(key []byte) [][]byte {
+	return self.Trie.Prove(crypto.Sha3(key))
+}
+
+// VerifyProof checks proof against the trie root hash and returns the value
+// stored under key, or nil if the proof shows key is absent. Keys of a
+// SecureTrie must be hashed by the caller.
+func VerifyProof(root []byte, key []byte, proof [][]byte) ([]byte, error) {
+	nodes := make(map[string][]byte, len(proof))
+	for _, data := range proof {
+		nodes[string(crypto.Sha3(data))] = data
+	}
+
+	k := CompactHexDecode(string(key))
+	data, ok := nodes[string(root)]
+	if !ok {
+		return nil, ErrMissingProofNode
+	}
+	node := common.NewValueFromBytes(data)
+	for {
+		switch node.Len() {
+		case 2:
+			nk := CompactDecode(string(node.Get(0).Bytes()))
+			if nk[len(nk)-1] == 16 {
+				if !bytes.Equal(nk, k) {
+					return nil, nil
+				}
+				return node.Get(1).Bytes(), nil
+			}
+			if len(k) < len(nk) || !bytes.Equal(nk, k[:len(nk)]) {
+				return nil, nil
+			}
+			k = k[len(nk):]
+			node = node.Get(1)
+		case 17:
+			if len(k) == 0 {
+				return nil, ErrInvalidProof
+			}
+			node = node.Get(int(k[0]))
+			k = k[1:]
+		default:
+			return nil, ErrInvalidProof
+		}
+
+		// Children are either embedded, a hash reference or empty.
+		if node.IsList() {
+			continue
+		}
+		ref := node.Bytes()
+		if len(ref) == 0 {
+			return nil, nil
+		}
+		if data, ok = nodes[string(ref)]; !ok {
+			return nil, ErrMissingProofNode
+		}
+		node = common.NewValueFromBytes(data)
+	}
+}
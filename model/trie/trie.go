@@ -99,6 +99,27 @@ func (self *Trie) Commit() {
 	self.cache.Flush()
 }
 
+// CommitTo hashes the trie and adds its new nodes to batch instead of writing
+// them, so several tries can be committed together. Committed must be called
+// once batch is written, until then the nodes are added again by the next
+// commit.
+func (self *Trie) CommitTo(batch common.Batch) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	self.Hash()
+
+	self.cache.FlushTo(batch)
+}
+
+// Committed marks the nodes added to a batch by CommitTo as written.
+func (self *Trie) Committed() {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	self.cache.Flushed()
+}
+
 // Reset should only be called if the trie has been hashed
 func (self *Trie) Reset() {
 	self.mu.Lock()
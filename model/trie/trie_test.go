@@ -13,6 +13,39 @@ type Db map[string][]byte
 
 func (self Db) Get(k []byte) ([]byte, error) { return self[string(k)], nil }
 func (self Db) Put(k, v []byte) error        { self[string(k)] = v; return nil }
+func (self Db) NewBatch() common.Batch       { return &dbBatch{self, make(Db)} }
+
+type dbBatch struct{ db, writes Db }
+
+func (self *dbBatch) Put(k, v []byte) error { self.writes[string(k)] = v; return nil }
+func (self *dbBatch) Delete(k []byte) error { delete(self.writes, string(k)); return nil }
+func (self *dbBatch) Reset()                { self.writes = make(Db) }
+func (self *dbBatch) Write() error {
+	for k, v := range self.writes {
+		self.db[k] = v
+	}
+	return nil
+}
+
+// failingDb fails to write batches while fail is set.
+type failingDb struct {
+	Db
+	fail bool
+}
+
+func (self *failingDb) NewBatch() common.Batch { return &failingBatch{self.Db.NewBatch(), self} }
+
+type failingBatch struct {
+	common.Batch
+	db *failingDb
+}
+
+func (self *failingBatch) Write() error {
+	if self.db.fail {
+		return fmt.Errorf("write failed")
+	}
+	return self.Batch.Write()
+}
 
 // Used for testing
 func NewEmpty() *Trie {
@@ -336,3 +369,51 @@ func TestSecureDelete(t *testing.T) {
 		t.Errorf("expected %x got %x", exp, hash)
 	}
 }
+
+func TestProof(t *testing.T) {
+	trie := NewEmptySecure()
+	for i := byte(0); i < 100; i++ {
+		trie.Update([]byte{i}, bytes.Repeat([]byte{i + 1}, int(i%40)+1))
+	}
+	root := trie.Hash()
+
+	for i := byte(0); i < 100; i++ {
+		key := crypto.Sha3([]byte{i})
+		value, err := VerifyProof(root, key, trie.Prove([]byte{i}))
+		if err != nil {
+			t.Fatalf("key %d: %v", i, err)
+		}
+		if exp := bytes.Repeat([]byte{i + 1}, int(i%40)+1); !bytes.Equal(value, exp) {
+			t.Errorf("key %d: expected %x got %x", i, exp, value)
+		}
+	}
+
+	value, err := VerifyProof(root, crypto.Sha3([]byte("missing")), trie.Prove([]byte("missing")))
+	if err != nil || value != nil {
+		t.Errorf("expected absence proof, got %x, %v", value, err)
+	}
+
+	proof := trie.Prove([]byte{1})
+	if _, err := VerifyProof(root, crypto.Sha3([]byte{1}), proof[:len(proof)-1]); err != ErrMissingProofNode {
+		t.Errorf("expected %v, got %v", ErrMissingProofNode, err)
+	}
+}
+
+func TestFlushAfterFailedWrite(t *testing.T) {
+	db := &failingDb{Db: make(Db), fail: true}
+	cache := NewCache(db)
+	cache.Put([]byte("key"), []byte("node"))
+	cache.Flush()
+	if len(db.Db) != 0 {
+		t.Fatalf("expected nothing written, got %d nodes", len(db.Db))
+	}
+
+	db.fail = false
+	cache.Flush()
+	if res := db.Db["key"]; string(res) != "node" {
+		t.Errorf("expected the node to be written again, got %q", res)
+	}
+	if len(cache.pending) != 0 {
+		t.Errorf("expected no pending nodes, got %d", len(cache.pending))
+	}
+}
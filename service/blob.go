@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/frame"
+)
+
+// maxBlobRead is the most bytes a single GetBlob returns.
+const maxBlobRead = 4 << 20
+
+var ErrNoBlobs = errors.New("blob storage is not available")
+
+// PutBlob appends data to an upload, starting one if uploadID is empty. If
+// finish is set the upload is completed and the root and size of the blob
+// are returned.
+func PutBlob(ctx context.Context, uploadID string, data []byte, finish bool) (id string, root *common.Hash, size uint64, err error) {
+	blobs := frame.GetBlobs()
+	if blobs == nil {
+		return "", nil, 0, ErrNoBlobs
+	}
+	if id = uploadID; id == "" {
+		if id, err = blobs.Begin(); err != nil {
+			return "", nil, 0, err
+		}
+	}
+	if len(data) > 0 {
+		if err = blobs.Append(id, data); err != nil {
+			return id, nil, 0, err
+		}
+	}
+	if !finish {
+		return id, nil, 0, nil
+	}
+	hash, size, err := blobs.Finish(id)
+	if err != nil {
+		return id, nil, 0, err
+	}
+	return id, &hash, size, nil
+}
+
+// GetBlob reads length bytes of a blob from offset on, or up to maxBlobRead
+// if length is zero, and returns them with the size of the blob.
+func GetBlob(ctx context.Context, root common.Hash, offset, length uint64) ([]byte, uint64, error) {
+	blobs := frame.GetBlobs()
+	if blobs == nil {
+		return nil, 0, ErrNoBlobs
+	}
+	if length == 0 || length > maxBlobRead {
+		length = maxBlobRead
+	}
+	return blobs.ReadAt(root, offset, length)
+}
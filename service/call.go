@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/MonteCarloClub/KBD/chain_manager"
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/execution"
+	"github.com/MonteCarloClub/KBD/frame"
+	"github.com/MonteCarloClub/KBD/model/kbpool"
+	"github.com/MonteCarloClub/KBD/model/state"
+	"github.com/MonteCarloClub/KBD/types"
+)
+
+// CallArgs describes a message that is executed without being committed.
+// Nil Gas defaults to the block gas limit, nil GasPrice and Value to zero.
+type CallArgs struct {
+	From     common.Address
+	To       *common.Address
+	Data     []byte
+	Gas      *big.Int
+	GasPrice *big.Int
+	Value    *big.Int
+	// BlockNumber selects the block whose state the message runs on. A nil
+	// BlockNumber runs it on the current state.
+	BlockNumber *uint64
+}
+
+// CallResult holds the outcome of a call. Err is the error reported by the
+// virtual machine, if any.
+type CallResult struct {
+	Output  []byte
+	GasUsed *big.Int
+	Err     error
+}
+
+// callMsg implements kbpool.Message for messages that are never signed.
+type callMsg struct {
+	from              common.Address
+	to                *common.Address
+	gas, price, value *big.Int
+	data              []byte
+	nonce             uint64
+}
+
+func (self callMsg) From() (common.Address, error) { return self.from, nil }
+func (self callMsg) To() *common.Address           { return self.to }
+func (self callMsg) GasPrice() *big.Int            { return self.price }
+func (self callMsg) Gas() *big.Int                 { return self.gas }
+func (self callMsg) Value() *big.Int               { return self.value }
+func (self callMsg) Nonce() uint64                 { return self.nonce }
+func (self callMsg) Data() []byte                  { return self.data }
+
+// callState returns a throwaway state for the given block together with the
+// block's header. Nothing written to the returned state is ever committed.
+func callState(bc *chain_manager.ChainManager, number *uint64) (*state.StateDB, *types.Header, error) {
+	if number == nil {
+		return frame.GetState().Copy(), bc.CurrentBlock().Header(), nil
+	}
+	block, err := blockAt(nil, number)
+	if err != nil {
+		return nil, nil, err
+	}
+	statedb, err := openState(block.Root())
+	if err != nil {
+		return nil, nil, err
+	}
+	return statedb, block.Header(), nil
+}
+
+func newCallMsg(statedb *state.StateDB, header *types.Header, args CallArgs) callMsg {
+	msg := callMsg{
+		from:  args.From,
+		to:    args.To,
+		gas:   args.Gas,
+		price: args.GasPrice,
+		value: args.Value,
+		data:  args.Data,
+		nonce: statedb.GetNonce(args.From),
+	}
+	if msg.gas == nil {
+		msg.gas = new(big.Int).Set(header.GasLimit)
+	}
+	if msg.price == nil {
+		msg.price = new(big.Int)
+	}
+	if msg.value == nil {
+		msg.value = new(big.Int)
+	}
+	return msg
+}
+
+// Call executes a message against a copy of the requested state and discards
+// every change it makes.
+func Call(ctx context.Context, args CallArgs) (*CallResult, error) {
+	bc, err := chainManager()
+	if err != nil {
+		return nil, err
+	}
+	statedb, header, err := callState(bc, args.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	msg := newCallMsg(statedb, header, args)
+
+	env := chain_manager.NewEnv(statedb, bc, msg, header)
+	gas := new(big.Int).Set(msg.gas)
+	exe := execution.NewExecution(env, msg.to, msg.data, gas, msg.price, msg.value)
+	caller := statedb.GetOrNewStateObject(msg.from)
+
+	res := &CallResult{}
+	if msg.to == nil {
+		res.Output, res.Err, _ = exe.Create(caller)
+	} else {
+		res.Output, res.Err = exe.Call(*msg.to, caller)
+	}
+	res.GasUsed = new(big.Int).Sub(msg.gas, gas)
+	return res, nil
+}
+
+// GasEstimate holds the outcome of a gas estimation. Err is set to the
+// failure of the message at the highest allowed gas limit if no limit lets
+// it execute successfully, in which case Gas is nil.
+type GasEstimate struct {
+	Gas *big.Int
+	Err error
+}
+
+// applyCall applies msg with the given gas limit to a copy of statedb the
+// way a transaction would be applied in a block.
+func applyCall(bc *chain_manager.ChainManager, statedb *state.StateDB, header *types.Header, msg callMsg, gas uint64) error {
+	statedb = statedb.Copy()
+	msg.gas = new(big.Int).SetUint64(gas)
+	coinbase := statedb.GetOrNewStateObject(header.Coinbase)
+	coinbase.SetGasLimit(header.GasLimit)
+
+	env := chain_manager.NewEnv(statedb, bc, msg, header)
+	_, _, err := kbpool.ApplyMessage(env, msg, coinbase)
+	return err
+}
+
+// EstimateGas binary-searches the lowest gas limit between the intrinsic gas
+// of the message and the block gas limit, or the message gas if lower, with
+// which the message executes without error.
+func EstimateGas(ctx context.Context, args CallArgs) (*GasEstimate, error) {
+	bc, err := chainManager()
+	if err != nil {
+		return nil, err
+	}
+	statedb, header, err := callState(bc, args.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	msg := newCallMsg(statedb, header, args)
+
+	hi := header.GasLimit.Uint64()
+	if args.Gas != nil && args.Gas.Cmp(header.GasLimit) < 0 {
+		hi = args.Gas.Uint64()
+	}
+	lo := kbpool.IntrinsicGas(msg.data).Uint64() - 1
+	if hi <= lo {
+		return &GasEstimate{Err: kbpool.ErrIntrinsicGas}, nil
+	}
+	if err := applyCall(bc, statedb, header, msg, hi); err != nil {
+		return &GasEstimate{Err: err}, nil
+	}
+	for lo+1 < hi {
+		mid := lo + (hi-lo)/2
+		if applyCall(bc, statedb, header, msg, mid) == nil {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return &GasEstimate{Gas: new(big.Int).SetUint64(hi)}, nil
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/model/kbpool"
+)
+
+func TestEstimateGas(t *testing.T) {
+	to := common.HexToAddress("0x945304eb96065b2a98b57a48a06ae28d285a71b4")
+	data := []byte{0, 1, 2, 3}
+	args := CallArgs{From: common.HexToAddress("0xa94f5374fce5edbc8e2a8697c15331677e6ebf0b"), To: &to, Data: data}
+
+	// A plain transfer needs exactly its intrinsic gas, so the search has to
+	// converge on the lower bound.
+	est, err := EstimateGas(context.Background(), args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if est.Err != nil || est.Gas == nil || est.Gas.Cmp(kbpool.IntrinsicGas(data)) != 0 {
+		t.Errorf("expected %v gas, got %v (%v)", kbpool.IntrinsicGas(data), est.Gas, est.Err)
+	}
+
+	args.Gas = new(big.Int).Sub(kbpool.IntrinsicGas(data), common.Big1)
+	if est, err = EstimateGas(context.Background(), args); err != nil {
+		t.Fatal(err)
+	}
+	if est.Err != kbpool.ErrIntrinsicGas || est.Gas != nil {
+		t.Errorf("expected %v, got %v (%v)", kbpool.ErrIntrinsicGas, est.Gas, est.Err)
+	}
+}
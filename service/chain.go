@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/MonteCarloClub/KBD/chain_manager"
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/frame"
+	"github.com/MonteCarloClub/KBD/types"
+)
+
+var ErrChainUnavailable = errors.New("chain manager unavailable")
+
+func chainManager() (*chain_manager.ChainManager, error) {
+	bc := frame.GetChainManager()
+	if bc == nil {
+		return nil, ErrChainUnavailable
+	}
+	return bc, nil
+}
+
+// GetTransactionByHash looks a canonical transaction up in the extra database.
+func GetTransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, *chain_manager.TxExtra) {
+	return chain_manager.GetTransaction(frame.GetExtraDB(), hash)
+}
+
+// GetTransactionReceipt returns the receipt of a canonical transaction, its
+// position in the chain and the gas used by the transaction alone.
+func GetTransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, *chain_manager.TxExtra, *big.Int, error) {
+	bc, err := chainManager()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	receipt := chain_manager.GetReceipt(frame.GetExtraDB(), hash)
+	if receipt == nil {
+		return nil, nil, nil, nil
+	}
+	_, extra := chain_manager.GetTransaction(frame.GetExtraDB(), hash)
+	if extra == nil {
+		return receipt, nil, nil, nil
+	}
+
+	gasUsed := new(big.Int).Set(receipt.CumulativeGasUsed)
+	if extra.Index > 0 {
+		block := bc.GetBlock(extra.BlockHash)
+		if block == nil || extra.Index >= uint64(len(block.Transactions())) {
+			return receipt, extra, nil, nil
+		}
+		prev := chain_manager.GetReceipt(frame.GetExtraDB(), block.Transactions()[extra.Index-1].Hash())
+		if prev == nil {
+			return receipt, extra, nil, nil
+		}
+		gasUsed.Sub(gasUsed, prev.CumulativeGasUsed)
+	}
+	return receipt, extra, gasUsed, nil
+}
+
+func GetBlockByNumber(ctx context.Context, number uint64) (*types.Block, error) {
+	bc, err := chainManager()
+	if err != nil {
+		return nil, err
+	}
+	return bc.GetBlockByNumber(number), nil
+}
+
+func GetBlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	bc, err := chainManager()
+	if err != nil {
+		return nil, err
+	}
+	return bc.GetBlock(hash), nil
+}
+
+func GetLatestBlock(ctx context.Context) (*types.Block, error) {
+	bc, err := chainManager()
+	if err != nil {
+		return nil, err
+	}
+	return bc.CurrentBlock(), nil
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/MonteCarloClub/KBD/frame"
+	"github.com/MonteCarloClub/KBD/model/changelog"
+)
+
+const (
+	defaultChangeLimit = 100
+	maxChangeLimit     = 1000
+	maxChangeWait      = time.Minute
+)
+
+var ErrNoChangeLog = errors.New("change log is not available")
+
+// SubscribeChanges returns the changes from sequence number from on, waiting
+// up to wait for the first one if there is none yet. Consumers resume by
+// passing the sequence number after the last change they got.
+func SubscribeChanges(ctx context.Context, from uint64, limit int, wait time.Duration) ([]changelog.Change, error) {
+	log := frame.GetChangeLog()
+	if log == nil {
+		return nil, ErrNoChangeLog
+	}
+	if limit <= 0 {
+		limit = defaultChangeLimit
+	} else if limit > maxChangeLimit {
+		limit = maxChangeLimit
+	}
+	if wait > maxChangeWait {
+		wait = maxChangeWait
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		wait = time.Until(deadline)
+	}
+	if wait <= 0 {
+		return log.Read(from, limit)
+	}
+	return log.Wait(from, limit, wait)
+}
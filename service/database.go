@@ -2,18 +2,206 @@ package service
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/MonteCarloClub/KBD/common"
 	"github.com/MonteCarloClub/KBD/frame"
 	"github.com/MonteCarloClub/KBD/kitex_gen/api"
+	"github.com/MonteCarloClub/KBD/model/kvtx"
+	"github.com/syndtr/goleveldb/leveldb"
 )
 
+const (
+	defaultScanLimit = 100
+	maxScanLimit     = 1000
+
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 1000
+)
+
+var ErrNoKVStore = errors.New("kv storage is not available")
+
+// txManager returns the manager of the KV data, failing if its store could
+// not be opened.
+func txManager() (*kvtx.Manager, error) {
+	m := frame.GetTxManager()
+	if m == nil {
+		return nil, ErrNoKVStore
+	}
+	return m, nil
+}
+
+// namespace returns the namespace a request is made in, the default one if
+// it names none.
+func namespace(ns string) (string, error) {
+	if ns == "" {
+		ns = kvtx.DefaultNamespace
+	}
+	m, err := txManager()
+	if err != nil {
+		return "", err
+	}
+	return ns, m.CheckNamespace(ns)
+}
+
+// dataKey returns the store key of key in namespace ns.
+func dataKey(ns, key string) ([]byte, error) {
+	ns, err := namespace(ns)
+	if err != nil {
+		return nil, err
+	}
+	return kvtx.DataKey(ns, []byte(key)), nil
+}
+
 // GetData implements the KanBanDatabaseImpl interface.
-func GetData(ctx context.Context, req *api.GetDataRequest) (valuse []byte, err error) {
-	return frame.GetDB().Get([]byte(req.Key))
+func GetData(ctx context.Context, req *api.GetDataRequest) (value []byte, version uint64, err error) {
+	key, err := dataKey(req.GetNamespace(), req.Key)
+	if err != nil {
+		return nil, 0, err
+	}
+	return frame.GetTxManager().Get(key)
 }
 
 // PutData implements the KanBanDatabaseImpl interface.
-func PutData(ctx context.Context, req *api.PutDataRequest) (err error) {
-	err = frame.GetDB().Put([]byte(req.Key), []byte(req.Value))
-	return err
+func PutData(ctx context.Context, req *api.PutDataRequest) (version uint64, err error) {
+	key, err := dataKey(req.GetNamespace(), req.Key)
+	if err != nil {
+		return 0, err
+	}
+	return frame.GetTxManager().Put(key, []byte(req.Value))
+}
+
+// CompareAndSwap writes key only if its version is still expected.
+func CompareAndSwap(ctx context.Context, ns, key string, expected uint64, value string) (version uint64, err error) {
+	dkey, err := dataKey(ns, key)
+	if err != nil {
+		return 0, err
+	}
+	return frame.GetTxManager().CompareAndSwap(dkey, expected, []byte(value))
+}
+
+// GetDataAt returns a past revision of key, selected by version or, if
+// version is nil, by the time it was current at.
+func GetDataAt(ctx context.Context, ns, key string, version *uint64, at time.Time) (kvtx.Revision, error) {
+	dkey, err := dataKey(ns, key)
+	if err != nil {
+		return kvtx.Revision{}, err
+	}
+	if version != nil {
+		return frame.GetTxManager().GetAt(dkey, *version)
+	}
+	return frame.GetTxManager().GetAtTime(dkey, at)
+}
+
+// GetDataHistory returns the latest revisions of key, newest first.
+func GetDataHistory(ctx context.Context, ns, key string, limit int) ([]kvtx.Revision, error) {
+	dkey, err := dataKey(ns, key)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	} else if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+	return frame.GetTxManager().History(dkey, limit), nil
+}
+
+// GetDataWithProof returns the value of key as of the last recorded root of
+// the KV trie together with a Merkle proof against that root. The trie holds
+// the store keys, see kvtx.DataKey.
+func GetDataWithProof(ctx context.Context, ns, key string) (value []byte, found bool, root common.Hash, proof [][]byte, err error) {
+	dkey, err := dataKey(ns, key)
+	if err != nil {
+		return nil, false, root, nil, err
+	}
+	return frame.GetTxManager().GetWithProof(dkey)
+}
+
+// MultiGet returns the values of the given keys. Missing keys are left out.
+func MultiGet(ctx context.Context, ns string, keys []string) (map[string][]byte, error) {
+	ns, err := namespace(ns)
+	if err != nil {
+		return nil, err
+	}
+	db := frame.GetKVDB()
+	values := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		value, err := db.Get(kvtx.DataKey(ns, []byte(key)))
+		if err == leveldb.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
+// MultiPut writes all entries in a single batch.
+func MultiPut(ctx context.Context, ns string, entries []*api.KeyValue) error {
+	ns, err := namespace(ns)
+	if err != nil {
+		return err
+	}
+	keys := make([][]byte, len(entries))
+	values := make([][]byte, len(entries))
+	for i, entry := range entries {
+		keys[i] = kvtx.DataKey(ns, []byte(entry.Key))
+		values[i] = []byte(entry.Value)
+	}
+	return frame.GetTxManager().WriteBatch(keys, values, nil)
+}
+
+func DeleteData(ctx context.Context, ns, key string) error {
+	dkey, err := dataKey(ns, key)
+	if err != nil {
+		return err
+	}
+	return frame.GetTxManager().Delete(dkey)
+}
+
+// Scan returns a page of the entries matching prefix in [start, end). A
+// non-empty cursor resumes a previous scan in place of start.
+func Scan(ctx context.Context, ns, prefix, start, end, cursor string, limit int) (keys, values [][]byte, next []byte, err error) {
+	ns, err = namespace(ns)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if cursor != "" {
+		start = cursor
+	}
+	if limit <= 0 {
+		limit = defaultScanLimit
+	} else if limit > maxScanLimit {
+		limit = maxScanLimit
+	}
+	return frame.GetTxManager().Scan(ns, []byte(prefix), []byte(start), []byte(end), limit)
+}
+
+func CreateNamespace(ctx context.Context, name string) error {
+	m, err := txManager()
+	if err != nil {
+		return err
+	}
+	return m.CreateNamespace(name)
+}
+
+func ListNamespaces(ctx context.Context) ([]string, error) {
+	m, err := txManager()
+	if err != nil {
+		return nil, err
+	}
+	return m.Namespaces()
+}
+
+// DropNamespace deletes a namespace together with all of its keys.
+func DropNamespace(ctx context.Context, name string) error {
+	m, err := txManager()
+	if err != nil {
+		return err
+	}
+	return m.DropNamespace(name)
 }
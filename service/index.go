@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/MonteCarloClub/KBD/frame"
+	"github.com/MonteCarloClub/KBD/model/kvtx"
+)
+
+// CreateIndex indexes the keys of ns starting with prefix by the JSON value
+// at path.
+func CreateIndex(ctx context.Context, ns, name, prefix, path string) error {
+	ns, err := namespace(ns)
+	if err != nil {
+		return err
+	}
+	return frame.GetTxManager().CreateIndex(kvtx.Index{Namespace: ns, Name: name, Prefix: prefix, Path: path})
+}
+
+func DropIndex(ctx context.Context, ns, name string) error {
+	ns, err := namespace(ns)
+	if err != nil {
+		return err
+	}
+	return frame.GetTxManager().DropIndex(ns, name)
+}
+
+func ListIndexes(ctx context.Context, ns string) ([]kvtx.Index, error) {
+	ns, err := namespace(ns)
+	if err != nil {
+		return nil, err
+	}
+	return frame.GetTxManager().Indexes(ns)
+}
+
+// indexBound decodes a JSON scalar a query compares with, nil if unset.
+func indexBound(data *string) (interface{}, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(*data), &v); err != nil || v == nil {
+		return nil, kvtx.ErrInvalidQuery
+	}
+	return v, nil
+}
+
+// QueryByIndex returns a page of the entries of an index whose value equals
+// equals, or lies in [from, to). Values are given as JSON.
+func QueryByIndex(ctx context.Context, ns, index string, equals, from, to *string, cursor []byte, limit int) (keys, values [][]byte, next []byte, err error) {
+	ns, err = namespace(ns)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	q := kvtx.IndexQuery{Cursor: cursor, Limit: limit}
+	if q.Equals, err = indexBound(equals); err != nil {
+		return nil, nil, nil, err
+	}
+	if q.From, err = indexBound(from); err != nil {
+		return nil, nil, nil, err
+	}
+	if q.To, err = indexBound(to); err != nil {
+		return nil, nil, nil, err
+	}
+	if q.Limit <= 0 {
+		q.Limit = defaultScanLimit
+	} else if q.Limit > maxScanLimit {
+		q.Limit = maxScanLimit
+	}
+	return frame.GetTxManager().QueryIndex(ns, index, q)
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+
+	"github.com/MonteCarloClub/KBD/frame"
+)
+
+func BeginTx(ctx context.Context) (uint64, error) {
+	m, err := txManager()
+	if err != nil {
+		return 0, err
+	}
+	return m.Begin(), nil
+}
+
+func TxGet(ctx context.Context, id uint64, ns, key string) ([]byte, bool, error) {
+	dkey, err := dataKey(ns, key)
+	if err != nil {
+		return nil, false, err
+	}
+	return frame.GetTxManager().TxGet(id, dkey)
+}
+
+func TxPut(ctx context.Context, id uint64, ns, key, value string) error {
+	dkey, err := dataKey(ns, key)
+	if err != nil {
+		return err
+	}
+	return frame.GetTxManager().TxPut(id, dkey, []byte(value))
+}
+
+func TxDelete(ctx context.Context, id uint64, ns, key string) error {
+	dkey, err := dataKey(ns, key)
+	if err != nil {
+		return err
+	}
+	return frame.GetTxManager().TxDelete(id, dkey)
+}
+
+func Commit(ctx context.Context, id uint64) error {
+	m, err := txManager()
+	if err != nil {
+		return err
+	}
+	return m.Commit(id)
+}
+
+func Rollback(ctx context.Context, id uint64) error {
+	m, err := txManager()
+	if err != nil {
+		return err
+	}
+	return m.Rollback(id)
+}
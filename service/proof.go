@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/model/state"
+)
+
+// StorageProof proves the value of a storage slot against the storage root
+// of its account.
+type StorageProof struct {
+	Key   common.Hash
+	Value common.Hash
+	Proof [][]byte
+}
+
+// AccountProof proves an account and some of its storage slots against a
+// state root. Account is nil if the account does not exist, in which case
+// Proof proves its absence.
+type AccountProof struct {
+	Root         common.Hash
+	Account      *state.StateObject
+	Proof        [][]byte
+	StorageProof []StorageProof
+}
+
+// GetProof builds Merkle proofs for an account and the given storage keys.
+func GetProof(ctx context.Context, address common.Address, keys []common.Hash, ref StateRef) (*AccountProof, error) {
+	stateDB, err := stateAt(ref)
+	if err != nil {
+		return nil, err
+	}
+	res := &AccountProof{
+		Root:    stateDB.Root(),
+		Account: stateDB.GetStateObject(address),
+		Proof:   stateDB.Trie().Prove(address[:]),
+	}
+	if res.Account == nil {
+		return res, nil
+	}
+	for _, key := range keys {
+		res.StorageProof = append(res.StorageProof, StorageProof{
+			Key:   key,
+			Value: res.Account.GetState(key),
+			Proof: res.Account.Trie().Prove(key[:]),
+		})
+	}
+	return res, nil
+}
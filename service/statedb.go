@@ -2,32 +2,167 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	"github.com/MonteCarloClub/KBD/kitex_gen/api"
 
+	"github.com/MonteCarloClub/KBD/model/changelog"
 	"github.com/MonteCarloClub/KBD/model/state"
 
 	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/crypto"
 	"github.com/MonteCarloClub/KBD/frame"
+	"github.com/MonteCarloClub/KBD/types"
+	"github.com/cloudwego/kitex/pkg/klog"
 )
 
-func SetAccountData(ctx context.Context, req *api.SetAccountDataRequest) bool {
+var ErrUnknownBlock = errors.New("unknown block")
+var ErrUnknownRoot = errors.New("state root is unknown or has been pruned")
+
+var emptyRoot = common.BytesToHash(crypto.Sha3(common.Encode("")))
+
+const (
+	defaultStorageLimit = 100
+	maxStorageLimit     = 1000
+)
+
+// StateRef selects the state a query reads. At most one field is set, the
+// current state is read if none is.
+type StateRef struct {
+	Number *uint64
+	Hash   *common.Hash
+	Root   *common.Hash
+}
+
+// SetAccountData writes the account into the current state. The trie nodes
+// are on disk before the change is committed together with the new root.
+func SetAccountData(ctx context.Context, req *api.SetAccountDataRequest) error {
 	stateDB := frame.GetState()
 	address := common.HexToAddress(req.Address)
-	obj := StateObjectFromAccount(frame.GetDB(), address, req.GetBalance(), req.GetCode(), req.GetNonce())
-	stateDB.Trie().Commit()
+	obj := StateObjectFromAccount(frame.GetDB(), address, req.GetBalance(), req.GetCode(), req.GetNonce(), req.GetStorage())
+	obj.Trie().Commit()
 	stateDB.UpdateStateObject(obj)
-	frame.PutRoot(stateDB.Trie().Hash())
-	return true
+	stateDB.SetStateObject(obj)
+	stateDB.Trie().Commit()
+	if err := frame.GetDB().Flush(); err != nil {
+		klog.Errorf("[SetAccountData] flush state failed %v", err)
+		return err
+	}
+	change := changelog.Change{Kind: changelog.KindAccount, Key: address.Bytes(), Value: obj.RlpEncode()}
+	return frame.CommitAccount(stateDB.Trie().Hash(), change)
 }
 
-func GetAccountData(ctx context.Context, address string) *state.StateObject {
-	stateDB := frame.GetState()
+func GetAccountData(ctx context.Context, address string, ref StateRef) (*state.StateObject, error) {
+	stateDB, err := stateAt(ref)
+	if err != nil {
+		return nil, err
+	}
 	obj := stateDB.GetStateObject(common.HexToAddress(address))
-	return obj
+	return obj, nil
+}
+
+// GetCode returns the code of an account. found is false if the account does
+// not exist.
+func GetCode(ctx context.Context, address common.Address, ref StateRef) (code []byte, found bool, err error) {
+	stateDB, err := stateAt(ref)
+	if err != nil {
+		return nil, false, err
+	}
+	obj := stateDB.GetStateObject(address)
+	if obj == nil {
+		return nil, false, nil
+	}
+	return obj.Code(), true, nil
+}
+
+// blockAt returns the block with the given hash, or with the given number if
+// hash is nil.
+func blockAt(hash *common.Hash, number *uint64) (*types.Block, error) {
+	bc, err := chainManager()
+	if err != nil {
+		return nil, err
+	}
+	var block *types.Block
+	if hash != nil {
+		block = bc.GetBlock(*hash)
+	} else {
+		block = bc.GetBlockByNumber(*number)
+	}
+	if block == nil {
+		return nil, ErrUnknownBlock
+	}
+	return block, nil
 }
 
-func StateObjectFromAccount(db common.Database, address common.Address, balance string, code string, nonce string) *state.StateObject {
+// hasState reports whether the trie with the given root is in the state
+// database.
+func hasState(root common.Hash) bool {
+	if root == emptyRoot {
+		return true
+	}
+	data, _ := frame.GetDB().Get(root[:])
+	return len(data) > 0
+}
+
+// openState opens the state with the given root.
+func openState(root common.Hash) (*state.StateDB, error) {
+	if !hasState(root) {
+		return nil, ErrUnknownRoot
+	}
+	return state.New(root, frame.GetDB()), nil
+}
+
+// stateAt returns the state selected by ref.
+func stateAt(ref StateRef) (*state.StateDB, error) {
+	switch {
+	case ref.Root != nil:
+		return openState(*ref.Root)
+	case ref.Hash != nil || ref.Number != nil:
+		block, err := blockAt(ref.Hash, ref.Number)
+		if err != nil {
+			return nil, err
+		}
+		return openState(block.Root())
+	}
+	return frame.GetState(), nil
+}
+
+// GetStorageAt returns the value of a storage slot. found is false if the
+// account does not exist.
+func GetStorageAt(ctx context.Context, address common.Address, slot common.Hash, ref StateRef) (value common.Hash, found bool, err error) {
+	stateDB, err := stateAt(ref)
+	if err != nil {
+		return common.Hash{}, false, err
+	}
+	obj := stateDB.GetStateObject(address)
+	if obj == nil {
+		return common.Hash{}, false, nil
+	}
+	return obj.GetState(slot), true, nil
+}
+
+// GetAccountStorage returns up to limit storage entries following cursor in
+// trie order and the cursor of the next page. found is false if the account
+// does not exist.
+func GetAccountStorage(ctx context.Context, address common.Address, cursor []byte, limit int, ref StateRef) (entries []state.StorageEntry, next []byte, found bool, err error) {
+	stateDB, err := stateAt(ref)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	obj := stateDB.GetStateObject(address)
+	if obj == nil {
+		return nil, nil, false, nil
+	}
+	if limit <= 0 {
+		limit = defaultStorageLimit
+	} else if limit > maxStorageLimit {
+		limit = maxStorageLimit
+	}
+	entries, next = obj.StorageRange(cursor, limit)
+	return entries, next, true, nil
+}
+
+func StateObjectFromAccount(db common.Database, address common.Address, balance string, code string, nonce string, storage map[string]string) *state.StateObject {
 	obj := state.NewStateObject(address, db)
 	obj.SetBalance(common.Big(balance))
 
@@ -37,5 +172,10 @@ func StateObjectFromAccount(db common.Database, address common.Address, balance
 	obj.SetCode(common.Hex2Bytes(code))
 	obj.SetNonce(common.Big(nonce).Uint64())
 
+	for slot, value := range storage {
+		obj.SetState(common.HexToHash(slot), common.HexToHash(value))
+	}
+	obj.Update()
+
 	return obj
 }
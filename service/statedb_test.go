@@ -18,7 +18,7 @@ func TestPutAccountData(t *testing.T) {
 	balance := "0x17"
 	code := "0x6000355415600957005b60203560003555"
 	nonce := "0x00"
-	obj := StateObjectFromAccount(frame.GetDB(), address, balance, code, nonce)
+	obj := StateObjectFromAccount(frame.GetDB(), address, balance, code, nonce, nil)
 	stateDB.UpdateStateObject(obj)
 	stateDB.Sync()
 	frame.PutRoot(stateDB.Trie().Root())
@@ -32,3 +32,33 @@ func TestGetAccountData(t *testing.T) {
 	res := stateDB.GetStateObject(address)
 	fmt.Println(res)
 }
+
+func TestStateAt(t *testing.T) {
+	bc, err := chainManager()
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := bc.GetBlockByNumber(0)
+	number, hash, root := genesis.NumberU64(), genesis.Hash(), genesis.Root()
+	for _, ref := range []StateRef{{Number: &number}, {Hash: &hash}, {Root: &root}} {
+		statedb, err := stateAt(ref)
+		if err != nil {
+			t.Fatalf("%+v: %v", ref, err)
+		}
+		if statedb.Root() != root {
+			t.Errorf("%+v: expected root %x, got %x", ref, root, statedb.Root())
+		}
+	}
+
+	unknown := common.HexToHash("0x01")
+	missing := bc.CurrentBlock().NumberU64() + 1
+	if _, err := stateAt(StateRef{Number: &missing}); err != ErrUnknownBlock {
+		t.Error("expected", ErrUnknownBlock, "got", err)
+	}
+	if _, err := stateAt(StateRef{Hash: &unknown}); err != ErrUnknownBlock {
+		t.Error("expected", ErrUnknownBlock, "got", err)
+	}
+	if _, err := stateAt(StateRef{Root: &unknown}); err != ErrUnknownRoot {
+		t.Error("expected", ErrUnknownRoot, "got", err)
+	}
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/frame"
+	"github.com/MonteCarloClub/KBD/rlp"
+	"github.com/MonteCarloClub/KBD/types"
+)
+
+var ErrInvalidRLP = errors.New("Invalid transaction RLP")
+
+// TxSubmission is the outcome of handing a single transaction to the pool.
+type TxSubmission struct {
+	Hash common.Hash
+	Err  error
+}
+
+func decodeTransaction(rawTx []byte) (*types.Transaction, error) {
+	tx := new(types.Transaction)
+	if err := rlp.DecodeBytes(rawTx, tx); err != nil {
+		klog.Errorf("[decodeTransaction] decode tx failed %v", err)
+		return nil, ErrInvalidRLP
+	}
+	return tx, nil
+}
+
+// SubmitTransaction decodes an RLP encoded transaction and queues it in the tx pool.
+func SubmitTransaction(ctx context.Context, rawTx []byte) (common.Hash, error) {
+	tx, err := decodeTransaction(rawTx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), frame.GetTxPool().Add(tx)
+}
+
+// SubmitTransactions queues a batch of RLP encoded transactions in the tx pool.
+// Transactions that fail to decode are reported without reaching the pool.
+func SubmitTransactions(ctx context.Context, rawTxs [][]byte) []TxSubmission {
+	res := make([]TxSubmission, len(rawTxs))
+	var (
+		txs   []*types.Transaction
+		index []int
+	)
+	for i, rawTx := range rawTxs {
+		tx, err := decodeTransaction(rawTx)
+		if err != nil {
+			res[i].Err = err
+			continue
+		}
+		res[i].Hash = tx.Hash()
+		txs = append(txs, tx)
+		index = append(index, i)
+	}
+	if len(txs) == 0 {
+		return res
+	}
+	for i, err := range frame.GetTxPool().AddTransactions(txs) {
+		res[index[i]].Err = err
+	}
+	return res
+}
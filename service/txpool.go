@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+
+	"github.com/MonteCarloClub/KBD/common"
+	"github.com/MonteCarloClub/KBD/frame"
+	"github.com/MonteCarloClub/KBD/model/kbpool"
+	"github.com/MonteCarloClub/KBD/types"
+)
+
+func TxPoolStatus(ctx context.Context) (pending int, queued int) {
+	return frame.GetTxPool().Stats()
+}
+
+// TxPoolContent returns the pool content grouped by sender together with the
+// reason each queued transaction is not processable yet. If address is not
+// nil only the transactions sent from it are returned.
+func TxPoolContent(ctx context.Context, address *common.Address) (pending, queued map[common.Address]types.Transactions, reasons map[common.Hash]kbpool.QueuedReason) {
+	pool := frame.GetTxPool()
+	pending, queued = pool.Content()
+	reasons = pool.QueuedReasons()
+	if address != nil {
+		pending = filterSender(pending, *address)
+		queued = filterSender(queued, *address)
+	}
+	return pending, queued, reasons
+}
+
+func filterSender(content map[common.Address]types.Transactions, address common.Address) map[common.Address]types.Transactions {
+	res := make(map[common.Address]types.Transactions)
+	if txs, ok := content[address]; ok {
+		res[address] = txs
+	}
+	return res
+}